@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/openpixelsystems/edid-tool/edid"
 )
@@ -14,6 +16,13 @@ func main() {
 	outFilePtr := flag.String("out", "", "Output file")
 	displayNamePtr := flag.String("name", "", "Display name")
 	serialNumberPtr := flag.Uint("serial", 0, "Serial number")
+	displayIDPtr := flag.Bool("displayid", false, "Input is a standalone DisplayID structure")
+	wallPtr := flag.String("wall", "", "Generate a video wall EDID set with the given tile grid, e.g. 4x2")
+	tilePtr := flag.String("tile", "", "Video wall tile resolution, e.g. 1920x1080")
+	bezelPtr := flag.String("bezel", "0,0,0,0", "Video wall top, bottom, right and left bezel sizes in pixels")
+	topologyOUIPtr := flag.String("topology-oui", "", "Video wall topology ID OUI, e.g. 00-0C-03")
+	topologyProductPtr := flag.Uint("topology-product", 0, "Video wall topology ID product code")
+	topologySerialPtr := flag.Uint("topology-serial", 0, "Video wall topology ID serial number")
 
 	flag.Parse()
 
@@ -33,9 +42,37 @@ func main() {
 		fmt.Println(err)
 	}
 
+	if *displayIDPtr {
+		displayIDObj, err := edid.ReadDisplayID(data)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		warnings, _ := displayIDObj.Parse()
+		fmt.Printf("\nParsing finished with %d warnings:\n", len(warnings))
+		for _, warning := range warnings {
+			fmt.Println("\t", warning)
+		}
+
+		displayIDData := edid.GenerateDisplayID(&displayIDObj)
+		f, err = os.Create(*outFilePtr)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		if _, err := f.Write(displayIDData); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	edidObj, err := edid.ReadEDID(data)
 	if err != nil {
 		fmt.Println(err)
+		os.Exit(1)
 	}
 
 	warnings, _ := edidObj.Parse()
@@ -44,6 +81,7 @@ func main() {
 		fmt.Println("\t", warning)
 	}
 
+	// The name and serial number also apply to every EDID of a video wall
 	//edidObj.ModifyManufacturerId([3]byte{'O', 'P', 'S'})
 	nameDescriptor := edid.GenerateMonitorNameDescriptor(*displayNamePtr)
 	if *displayNamePtr != "" {
@@ -54,6 +92,56 @@ func main() {
 		edidObj.ModifySerialNumber(uint32(*serialNumberPtr))
 	}
 
+	if *wallPtr != "" {
+		if *topologyOUIPtr == "" {
+			fmt.Println("Video wall topology OUI is required, e.g. -topology-oui 00-0C-03")
+			os.Exit(1)
+		}
+		topology := edid.TiledDisplayTopology{
+			OneTileBehavior: 0x01,
+			NTileBehavior:   0x01,
+			PixelMultiplier: 1,
+			VendorID:        *topologyOUIPtr,
+			ProductCode:     uint16(*topologyProductPtr),
+			SerialNumber:    uint32(*topologySerialPtr),
+		}
+		if _, err := fmt.Sscanf(*wallPtr, "%dx%d", &topology.HorizontalTiles, &topology.VerticalTiles); err != nil {
+			fmt.Println("Invalid video wall grid:", *wallPtr)
+			os.Exit(1)
+		}
+		if _, err := fmt.Sscanf(*tilePtr, "%dx%d", &topology.TileWidth, &topology.TileHeight); err != nil {
+			fmt.Println("Invalid video wall tile resolution:", *tilePtr)
+			os.Exit(1)
+		}
+		if _, err := fmt.Sscanf(*bezelPtr, "%f,%f,%f,%f", &topology.TopBezel, &topology.BottomBezel, &topology.RightBezel, &topology.LeftBezel); err != nil {
+			fmt.Println("Invalid video wall bezel sizes:", *bezelPtr)
+			os.Exit(1)
+		}
+
+		edids, err := edid.GenerateVideoWall(&edidObj, topology)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		// One output file per tile, named after the output file and the tile location
+		ext := filepath.Ext(*outFilePtr)
+		for i, edidData := range edids {
+			name := fmt.Sprintf("%s_%d_%d%s", strings.TrimSuffix(*outFilePtr, ext), i%topology.HorizontalTiles, i/topology.HorizontalTiles, ext)
+			f, err = os.Create(name)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			_, err = f.Write(edidData)
+			f.Close()
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+		}
+		return
+	}
+
 	//edidObj.Parse()
 
 	edidData := edid.GenerateEDID(&edidObj)
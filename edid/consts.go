@@ -24,8 +24,8 @@ const (
 	EXTENSION_FLAG_SIZE           = 1   // 1 byte extension flag
 	CHECKSUM_SIZE                 = 1   // 1 byte checksum
 	EDID_SIZE                     = 128 // 128 bytes edid
-	CTA_SIZE                      = 128 // 128 bytes CTA extension
-	EXTENDED_EDID_SIZE            = 256 // 256 bytes edid
+	EXTENSION_SIZE                = 128 // 128 bytes extension block
+	EXTENSION_FLAG_OFFSET         = 126 // extension flag offset in the base block
 
 	CTA_EXT_TAG_SIZE        = 1 // 1 byte CTA extension tag
 	CTA_EXT_REVISION_SIZE   = 1 // 1 byte CTA extension revision
@@ -92,6 +92,48 @@ const (
 	DTD_TYPE_ADDITIONAL_STANDARD_TIMING     = 0xF7 // Additional standard timing
 	DTD_TYPE_DUMMY                          = 0x10 // Dummy
 
+	ESTABLISHED_TIMINGS_III_OFFSET = 6 // Established timings III bitmap offset in the descriptor
+
+	CVT_CODE_OFFSET         = 6    // First CVT 3-byte code offset in the descriptor
+	CVT_CODE_SIZE           = 3    // CVT 3-byte code size
+	CVT_CODE_COUNT          = 4    // CVT 3-byte codes per descriptor
+	CVT_CODE_LINES_MSB      = 0xF0 // Addressable lines bits 11:8
+	CVT_CODE_ASPECT_MASK    = 0x0C // Aspect ratio
+	CVT_CODE_PREFERRED_MASK = 0x60 // Preferred vertical rate
+	CVT_CODE_RATE_50        = 0x10 // 50 Hz standard blanking supported
+	CVT_CODE_RATE_60        = 0x08 // 60 Hz standard blanking supported
+	CVT_CODE_RATE_75        = 0x04 // 75 Hz standard blanking supported
+	CVT_CODE_RATE_85        = 0x02 // 85 Hz standard blanking supported
+	CVT_CODE_RATE_60_RB     = 0x01 // 60 Hz reduced blanking supported
+
+	WHITE_POINT_OFFSET          = 5    // First white point offset in the descriptor
+	WHITE_POINT_SIZE            = 5    // White point index, LSBs, x, y and gamma
+	WHITE_POINT_COUNT           = 2    // White points per descriptor
+	WHITE_POINT_X_LSB           = 0x0C // White point x bits 1:0
+	WHITE_POINT_Y_LSB           = 0x03 // White point y bits 1:0
+	WHITE_POINT_GAMMA_UNDEFINED = 0xFF // Gamma defined in an extension block
+
+	DCM_VERSION_OFFSET = 5    // Color management data version offset in the descriptor
+	DCM_VERSION        = 0x03 // Color management data version
+	DCM_OFFSET         = 6    // First color management coefficient offset in the descriptor
+
+	STANDARD_TIMING_ID_OFFSET = 5 // First standard timing offset in the descriptor
+	STANDARD_TIMING_ID_COUNT  = 6 // Standard timings per descriptor
+
+	RANGE_LIMITS_DEFAULT_GTF        = 0x00 // Default GTF supported
+	RANGE_LIMITS_ONLY               = 0x01 // Range limits only, no timing formula
+	RANGE_LIMITS_SECONDARY_GTF      = 0x02 // Secondary GTF supported
+	RANGE_LIMITS_CVT                = 0x04 // CVT supported, EDID 1.4 (0x03 is reserved)
+	RANGE_LIMITS_CVT_PRECISION_MASK = 0xFC // Max pixel clock reduction in 0.25 MHz steps
+	RANGE_LIMITS_CVT_ACTIVE_MSB     = 0x03 // Max active pixels per line bits 9:8
+	RANGE_LIMITS_CVT_ASPECT_MASK    = 0xE0 // Preferred aspect ratio
+	RANGE_LIMITS_CVT_RB             = 0x10 // CVT reduced blanking supported
+	RANGE_LIMITS_CVT_STANDARD       = 0x08 // CVT standard blanking supported
+	RANGE_LIMITS_CVT_H_SHRINK       = 0x80 // Horizontal shrink supported
+	RANGE_LIMITS_CVT_H_STRETCH      = 0x40 // Horizontal stretch supported
+	RANGE_LIMITS_CVT_V_SHRINK       = 0x20 // Vertical shrink supported
+	RANGE_LIMITS_CVT_V_STRETCH      = 0x10 // Vertical stretch supported
+
 	CTA_EXT_TAG_AUDIO_DATA_BLOCK              = 0x01 // Audio data block
 	CTA_EXT_TAG_VIDEO_DATA_BLOCK              = 0x02 // Video data block
 	CTA_EXT_TAG_VENDOR_SPECIFIC_DATA_BLOCK    = 0x03 // Vendor-specific data block
@@ -100,16 +142,321 @@ const (
 	CTA_EXT_TAG_VIDEO_FORMAT_DATA_BLOCK       = 0x06 // Video format data block
 	CTA_EXT_TAG_USE_EXTENDED_TAG              = 0x07 // Use extended tag
 
-	CTA_BLOCK_TILED_DISPLAY_LEGACY = 0x12 // Tiled display legacy
-	CTA_BLOCK_TILED_DISPLAY        = 0x28 // Tiled display
-	CTA_BLOCK_TILED_SIZE           = 25   // Tiled size
-	CTA_BLOCK_VTB_TYPE_1           = 0x03 // VTB type 1
-	CTA_VTB_TYPE_1_DESCRIPTOR_SIZE = 20   // VTB type 1 descriptor size
+	CTA_EXTENDED_TAG_VIDEO_CAPABILITY_DATA_BLOCK        = 0x00 // Video capability data block
+	CTA_EXTENDED_TAG_VENDOR_SPECIFIC_VIDEO_DATA_BLOCK   = 0x01 // Vendor-specific video data block
+	CTA_EXTENDED_TAG_VESA_DISPLAY_DEVICE_DATA_BLOCK     = 0x02 // VESA display device data block
+	CTA_EXTENDED_TAG_VESA_VIDEO_TIMING_DATA_BLOCK       = 0x03 // VESA video timing block extension
+	CTA_EXTENDED_TAG_COLORIMETRY_DATA_BLOCK             = 0x05 // Colorimetry data block
+	CTA_EXTENDED_TAG_HDR_STATIC_METADATA_DATA_BLOCK     = 0x06 // HDR static metadata data block
+	CTA_EXTENDED_TAG_HDR_DYNAMIC_METADATA_DATA_BLOCK    = 0x07 // HDR dynamic metadata data block
+	CTA_EXTENDED_TAG_NATIVE_VIDEO_RESOLUTION_DATA_BLOCK = 0x08 // Native video resolution data block
+	CTA_EXTENDED_TAG_VIDEO_FORMAT_PREFERENCE_DATA_BLOCK = 0x0D // Video format preference data block
+	CTA_EXTENDED_TAG_YCBCR420_VIDEO_DATA_BLOCK          = 0x0E // YCbCr 4:2:0 video data block
+	CTA_EXTENDED_TAG_YCBCR420_CAPABILITY_MAP_DATA_BLOCK = 0x0F // YCbCr 4:2:0 capability map data block
+	CTA_EXTENDED_TAG_VENDOR_SPECIFIC_AUDIO_DATA_BLOCK   = 0x11 // Vendor-specific audio data block
+	CTA_EXTENDED_TAG_HDMI_AUDIO_DATA_BLOCK              = 0x12 // HDMI audio data block
+	CTA_EXTENDED_TAG_ROOM_CONFIGURATION_DATA_BLOCK      = 0x13 // Room configuration data block
+	CTA_EXTENDED_TAG_SPEAKER_LOCATION_DATA_BLOCK        = 0x14 // Speaker location data block
+	CTA_EXTENDED_TAG_INFOFRAME_DATA_BLOCK               = 0x20 // InfoFrame data block
+	CTA_EXTENDED_TAG_DISPLAYID_TYPE_VII_DATA_BLOCK      = 0x22 // DisplayID type VII video timing data block
+	CTA_EXTENDED_TAG_DISPLAYID_TYPE_VIII_DATA_BLOCK     = 0x23 // DisplayID type VIII video timing data block
+	CTA_EXTENDED_TAG_DISPLAYID_TYPE_X_DATA_BLOCK        = 0x2A // DisplayID type X video timing data block
+	CTA_EXTENDED_TAG_HF_EEODB_DATA_BLOCK                = 0x78 // HDMI Forum EDID extension override data block
+	CTA_EXTENDED_TAG_HF_SCDB_DATA_BLOCK                 = 0x79 // HDMI Forum sink capability data block
+
+	CTA_EXT_TAG_CODE_MASK    = 0xE0 // Data block tag code
+	CTA_EXT_LENGTH_MASK      = 0x1F // Data block length
+	CTA_EXT_UNDERSCAN        = 0x80 // Underscans IT video formats by default
+	CTA_EXT_BASIC_AUDIO      = 0x40 // Basic audio supported
+	CTA_EXT_YCBCR444         = 0x20 // YCbCr 4:4:4 supported
+	CTA_EXT_YCBCR422         = 0x10 // YCbCr 4:2:2 supported
+	CTA_EXT_NATIVE_DTDS_MASK = 0x0F // Number of native DTDs
+
+	CTA_SVD_NATIVE     = 0x80 // Native video format
+	CTA_SVD_VIC_MASK   = 0x7F // Video identification code
+	CTA_SVD_NATIVE_MIN = 129  // First SVD value carrying the native flag
+	CTA_SVD_NATIVE_MAX = 192  // Last SVD value carrying the native flag
+
+	CTA_SPEAKER_ALLOCATION_SIZE   = 3    // 3 bytes speaker allocation bitmap
+	CTA_RCDB_MIN_LEN              = 4    // Flags and speaker allocation
+	CTA_RCDB_MAX_SIZE             = 7    // Flags, speaker allocation and Xmax/Ymax/Zmax
+	CTA_RCDB_DISPLAY_SIZE         = 10   // Flags, speaker allocation, maxima and display location
+	CTA_RCDB_DISPLAY              = 0x80 // Display location present
+	CTA_RCDB_SLD                  = 0x40 // Speaker location descriptors present
+	CTA_RCDB_SPEAKER              = 0x20 // Speaker count valid
+	CTA_RCDB_SPEAKER_COUNT_MASK   = 0x1F // Speaker count minus one
+	CTA_SLD_MIN_SIZE              = 2    // Channel index and speaker ID
+	CTA_SLD_COORD_SIZE            = 5    // Channel index, speaker ID and X/Y/Z coordinates
+	CTA_SLD_COORD                 = 0x40 // Coordinates present
+	CTA_SLD_ACTIVE                = 0x20 // Speaker is active
+	CTA_SLD_CHANNEL_MASK          = 0x1F // Channel index
+	CTA_SLD_SPEAKER_ID_MASK       = 0x1F // Speaker ID
+	CTA_SAD_SIZE                  = 3    // 3 bytes short audio descriptor
+	CTA_SAD_FORMAT_MASK           = 0x78 // Audio format code
+	CTA_SAD_CHANNELS_MASK         = 0x07 // Maximum number of channels - 1
+	CTA_SAD_SAMPLE_RATES_MASK     = 0x7F // Supported sample rates
+	CTA_SAD_EXTENSION_TYPE_MASK   = 0xF8 // Audio format extension type code
+	CTA_SAD_LPCM_BIT_DEPTHS_MASK  = 0x07 // Supported LPCM bit depths
+	CTA_SAD_PCM_3D_CHANNEL_BIT3   = 0x80 // L-PCM 3D audio channel count bit 3, in byte 2
+	CTA_SAD_PCM_3D_CHANNEL_BIT4   = 0x80 // L-PCM 3D audio channel count bit 4, in byte 1
+	CTA_SAD_EAC3_JOC              = 0x01 // E-AC-3 joint object coding
+	CTA_SAD_EAC3_JOC_ACMOD28      = 0x02 // E-AC-3 joint object coding with ACMOD28
+	CTA_SAD_MAT_OBJECT_AUDIO      = 0x01 // MAT object audio and channel-based PCM
+	CTA_SAD_MAT_HASH_NOT_REQUIRED = 0x02 // MAT hash calculation not required
+	CTA_SAD_AAC_FRAME_960         = 0x02 // AAC 960 samples frame length
+	CTA_SAD_AAC_FRAME_1024        = 0x04 // AAC 1024 samples frame length
+	CTA_SAD_MPS_EXPLICIT          = 0x01 // MPEG Surround explicitly signalled
+	CTA_SAD_MPEGH_LEVEL_MASK      = 0x07 // MPEG-H 3D audio level
+
+	HDMI_OUI          = 0x000C03 // HDMI Licensing, LLC
+	HDMI_FORUM_OUI    = 0xC45DD8 // HDMI Forum
+	CTA_OUI_SIZE      = 3        // 3 bytes IEEE OUI
+	HDMI_VSDB_MIN_LEN = 5        // OUI and physical address
+
+	HDMI_VSDB_SUPPORTS_AI         = 0x80 // Supports ACP, ISRC1 or ISRC2 packets
+	HDMI_VSDB_DC_48BIT            = 0x40 // 16 bits per component deep color
+	HDMI_VSDB_DC_36BIT            = 0x20 // 12 bits per component deep color
+	HDMI_VSDB_DC_30BIT            = 0x10 // 10 bits per component deep color
+	HDMI_VSDB_DC_Y444             = 0x08 // Deep color in YCbCr 4:4:4
+	HDMI_VSDB_DVI_DUAL            = 0x01 // DVI dual-link operation
+	HDMI_VSDB_LATENCY_PRESENT     = 0x80 // Video and audio latency fields present
+	HDMI_VSDB_I_LATENCY_PRESENT   = 0x40 // Interlaced video and audio latency fields present
+	HDMI_VSDB_HDMI_VIDEO_PRESENT  = 0x20 // HDMI video fields present
+	HDMI_VSDB_CNC_MASK            = 0x0F // Content type flags
+	HDMI_VSDB_3D_PRESENT          = 0x80 // 3D formats supported
+	HDMI_VSDB_3D_MULTI_MASK       = 0x60 // 3D multi present
+	HDMI_VSDB_IMAGE_SIZE_MASK     = 0x18 // Image size
+	HDMI_VSDB_VIC_LEN_MASK        = 0xE0 // HDMI VIC length
+	HDMI_VSDB_3D_LEN_MASK         = 0x1F // HDMI 3D length
+	HDMI_VSDB_LATENCY_UNKNOWN     = 0x00 // Latency unknown
+	HDMI_VSDB_LATENCY_UNSUPPORTED = 0xFF // Content type not supported
+	HDMI_3D_STRUCTURE_SBS_HALF    = 0x08 // Side-by-side (half), carries a 3D detail field
+
+	HF_SCDB_RESERVED_SIZE    = 2    // 2 reserved bytes in front of the HF-SCDB SCDS
+	HF_SCDS_MIN_LEN          = 4    // Version, max TMDS character rate and two flag bytes
+	HF_SCDS_SCDC_PRESENT     = 0x80 // SCDC present
+	HF_SCDS_RR_CAPABLE       = 0x40 // SCDC read request capable
+	HF_SCDS_CABLE_STATUS     = 0x20 // Cable status reporting
+	HF_SCDS_CCBPCI           = 0x10 // Color content bits per component indication
+	HF_SCDS_LTE_340_SCRAMBLE = 0x08 // Scrambling below 340 Mcsc
+	HF_SCDS_3D_INDEPENDENT   = 0x04 // 3D independent view signaling
+	HF_SCDS_3D_DUAL_VIEW     = 0x02 // 3D dual view signaling
+	HF_SCDS_3D_OSD_DISPARITY = 0x01 // 3D OSD disparity indication
+	HF_SCDS_MAX_FRL_MASK     = 0xF0 // Maximum FRL rate
+	HF_SCDS_UHD_VIC          = 0x08 // UHD VICs supported
+	HF_SCDS_DC_48BIT_420     = 0x04 // 16 bits per component deep color in YCbCr 4:2:0
+	HF_SCDS_DC_36BIT_420     = 0x02 // 12 bits per component deep color in YCbCr 4:2:0
+	HF_SCDS_DC_30BIT_420     = 0x01 // 10 bits per component deep color in YCbCr 4:2:0
+	HF_SCDS_FAPA_END_EXT     = 0x80 // Fast audio packet adjust end extended
+	HF_SCDS_QMS              = 0x40 // Quick media switching
+	HF_SCDS_M_DELTA          = 0x20 // M_CONST timing change support
+	HF_SCDS_CINEMA_VRR       = 0x10 // Cinema VRR
+	HF_SCDS_CNMVRR           = 0x08 // Negative M_VRR values supported
+	HF_SCDS_FVA              = 0x04 // Fast vactive
+	HF_SCDS_ALLM             = 0x02 // Auto low latency mode
+	HF_SCDS_FAPA_START       = 0x01 // Fast audio packet adjust start location
+	HF_SCDS_VRR_MAX_MSB_MASK = 0xC0 // VRRmax bits 9:8
+	HF_SCDS_VRR_MIN_MASK     = 0x3F // VRRmin
+	HF_SCDS_DSC_1P2          = 0x80 // VESA DSC 1.2a supported
+	HF_SCDS_DSC_NATIVE_420   = 0x40 // DSC compressed native YCbCr 4:2:0
+	HF_SCDS_QMS_TFR_MAX      = 0x20 // QMS TFRmax supported
+	HF_SCDS_QMS_TFR_MIN      = 0x10 // QMS TFRmin supported
+	HF_SCDS_DSC_ALL_BPP      = 0x08 // DSC all bits per pixel supported
+	HF_SCDS_DSC_16BPC        = 0x04 // DSC 16 bits per component
+	HF_SCDS_DSC_12BPC        = 0x02 // DSC 12 bits per component
+	HF_SCDS_DSC_10BPC        = 0x01 // DSC 10 bits per component
+	HF_SCDS_DSC_MAX_FRL_MASK = 0xF0 // DSC maximum FRL rate
+	HF_SCDS_DSC_SLICES_MASK  = 0x0F // DSC maximum slices
+	HF_SCDS_DSC_CHUNK_MASK   = 0x3F // DSC total chunk kbytes
+
+	CTA_COLORIMETRY_SIZE    = 2    // Colorimetry flags and gamut metadata profiles
+	CTA_COLORIMETRY_MD_MASK = 0x0F // Gamut metadata profiles
+	CTA_VIDEO_CAP_QY        = 0x80 // YCC quantization range selectable
+	CTA_VIDEO_CAP_QS        = 0x40 // RGB quantization range selectable
+	CTA_VIDEO_CAP_S_PT_MASK = 0x30 // Preferred video timing scan behavior
+	CTA_VIDEO_CAP_S_IT_MASK = 0x0C // IT video format scan behavior
+	CTA_VIDEO_CAP_S_CE_MASK = 0x03 // CE video format scan behavior
+	CTA_VIDEO_CAP_SIZE      = 1    // 1 byte video capability flags
+
+	HDR_STATIC_METADATA_MIN_LEN    = 2    // Supported EOTFs and static metadata descriptors
+	HDR_DYNAMIC_METADATA_TYPE_SIZE = 2    // 2 byte little-endian dynamic metadata type
+	HDR_DYNAMIC_METADATA_VERSION   = 0x0F // Dynamic metadata version
+	HDR_DYNAMIC_METADATA_TYPE_1    = 0x0001
+	HDR_DYNAMIC_METADATA_TYPE_2    = 0x0002
+	HDR_DYNAMIC_METADATA_TYPE_3    = 0x0003
+	HDR_DYNAMIC_METADATA_TYPE_4    = 0x0004
+	HDR_SL_HDR1                    = 0x10 // ETSI TS 103 433-1 (SL-HDR1)
+	HDR_SL_HDR2                    = 0x20 // ETSI TS 103 433-2 (SL-HDR2)
+	HDR_SL_HDR3                    = 0x40 // ETSI TS 103 433-3 (SL-HDR3)
+
+	CTA_AUDIO_FORMAT_LPCM      = 1  // L-PCM
+	CTA_AUDIO_FORMAT_AC3       = 2  // AC-3
+	CTA_AUDIO_FORMAT_MPEG1     = 3  // MPEG-1 (layers 1 & 2)
+	CTA_AUDIO_FORMAT_MP3       = 4  // MP3
+	CTA_AUDIO_FORMAT_MPEG2     = 5  // MPEG-2 multichannel
+	CTA_AUDIO_FORMAT_AAC_LC    = 6  // AAC LC
+	CTA_AUDIO_FORMAT_DTS       = 7  // DTS
+	CTA_AUDIO_FORMAT_ATRAC     = 8  // ATRAC
+	CTA_AUDIO_FORMAT_ONE_BIT   = 9  // One Bit Audio
+	CTA_AUDIO_FORMAT_EAC3      = 10 // Enhanced AC-3
+	CTA_AUDIO_FORMAT_DTS_HD    = 11 // DTS-HD
+	CTA_AUDIO_FORMAT_MAT       = 12 // MAT (MLP)
+	CTA_AUDIO_FORMAT_DST       = 13 // DST
+	CTA_AUDIO_FORMAT_WMA_PRO   = 14 // WMA Pro
+	CTA_AUDIO_FORMAT_EXTENSION = 15 // Audio format defined by the extension type code
+
+	CTA_AUDIO_EXT_TYPE_HE_AAC     = 4  // MPEG-4 HE AAC
+	CTA_AUDIO_EXT_TYPE_HE_AAC_V2  = 5  // MPEG-4 HE AAC v2
+	CTA_AUDIO_EXT_TYPE_AAC_LC     = 6  // MPEG-4 AAC LC
+	CTA_AUDIO_EXT_TYPE_DRA        = 7  // DRA
+	CTA_AUDIO_EXT_TYPE_HE_AAC_MPS = 8  // MPEG-4 HE AAC + MPEG Surround
+	CTA_AUDIO_EXT_TYPE_AAC_LC_MPS = 10 // MPEG-4 AAC LC + MPEG Surround
+	CTA_AUDIO_EXT_TYPE_MPEGH_3D   = 11 // MPEG-H 3D Audio
+	CTA_AUDIO_EXT_TYPE_AC4        = 12 // AC-4
+	CTA_AUDIO_EXT_TYPE_LPCM_3D    = 13 // L-PCM 3D Audio
+
+	EXTENSION_TAG_CTA          = 0x02 // CTA-861 extension
+	EXTENSION_TAG_VTB          = 0x10 // Video timing block extension
+	EXTENSION_TAG_DI           = 0x40 // Display information extension
+	EXTENSION_TAG_LS           = 0x50 // Localized string extension
+	EXTENSION_TAG_DPVL         = 0x60 // Digital packet video link extension
+	EXTENSION_TAG_DISPLAYID    = 0x70 // DisplayID extension
+	EXTENSION_TAG_BLOCK_MAP    = 0xF0 // Block map
+	EXTENSION_TAG_MANUFACTURER = 0xFF // Manufacturer specific extension
+
+	CTA_BLOCK_PRODUCT_ID_LEGACY        = 0x00 // Product identification legacy
+	CTA_BLOCK_DISPLAY_PARAMS_LEGACY    = 0x01 // Display parameters legacy
+	CTA_BLOCK_PRODUCT_ID               = 0x20 // Product identification
+	CTA_BLOCK_DISPLAY_PARAMS           = 0x21 // Display parameters
+	CTA_BLOCK_INTERFACE_FEATURES       = 0x26 // Display interface features
+	CTA_BLOCK_STEREO_INTERFACE         = 0x27 // Stereo display interface
+	CTA_BLOCK_CONTAINER_ID             = 0x29 // ContainerID
+	CTA_BLOCK_ADAPTIVE_SYNC            = 0x2B // Adaptive sync
+	CTA_BLOCK_VENDOR_SPECIFIC          = 0x7E // Vendor-specific
+	CTA_BLOCK_VENDOR_SPECIFIC_LEGACY   = 0x7F // Vendor-specific legacy
+	CTA_BLOCK_COLOR_CHARACTERISTICS    = 0x02 // Color characteristics legacy
+	CTA_BLOCK_VTB_TYPE_2               = 0x04 // VTB type 2, detailed timings in 8 pixel units
+	CTA_VTB_TYPE_2_DESCRIPTOR_SIZE     = 11   // VTB type 2 descriptor size
+	CTA_BLOCK_VTB_TYPE_3               = 0x05 // VTB type 3, short formula-based timings
+	CTA_VTB_TYPE_3_DESCRIPTOR_SIZE     = 3    // VTB type 3 descriptor size
+	CTA_BLOCK_VTB_TYPE_4               = 0x06 // VTB type 4, DMT ID codes
+	CTA_BLOCK_VESA_TIMINGS             = 0x07 // VESA timing standard bitmap
+	CTA_BLOCK_CTA_TIMINGS              = 0x08 // CTA-861 timing standard bitmap
+	CTA_BLOCK_RANGE_LIMITS             = 0x09 // Video timing range limits
+	CTA_BLOCK_SERIAL_NUMBER            = 0x0A // Product serial number
+	CTA_BLOCK_ASCII_STRING             = 0x0B // General purpose ASCII string
+	CTA_BLOCK_POWER_SEQUENCING         = 0x0D // Display device power sequencing
+	CTA_BLOCK_TRANSFER_CHARACTERISTICS = 0x0E // Transfer characteristics
+	CTA_BLOCK_DISPLAY_INTERFACE        = 0x0F // Display interface legacy
+	CTA_BLOCK_TILED_DISPLAY_LEGACY     = 0x12 // Tiled display legacy
+	CTA_BLOCK_TILED_DISPLAY            = 0x28 // Tiled display
+	CTA_BLOCK_TILED_SIZE               = 25   // Tiled size
+	CTA_BLOCK_VTB_TYPE_1               = 0x03 // VTB type 1
+	CTA_VTB_TYPE_1_DESCRIPTOR_SIZE     = 20   // VTB type 1 descriptor size
+	CTA_BLOCK_VTB_TYPE_7               = 0x22 // VTB type 7
+	CTA_VTB_TYPE_7_DESCRIPTOR_SIZE     = 20   // VTB type 7 descriptor size
+	CTA_BLOCK_VTB_TYPE_8               = 0x23 // VTB type 8, enumerated timing codes
+	CTA_BLOCK_VTB_TYPE_9               = 0x24 // VTB type 9, formula-based timings
+	CTA_VTB_TYPE_9_DESCRIPTOR_SIZE     = 6    // VTB type 9 descriptor size
+	CTA_BLOCK_VTB_TYPE_10              = 0x2A // VTB type 10, formula-based timings
+	CTA_VTB_TYPE_10_MIN_SIZE           = 6    // VTB type 10 descriptor size without additional bytes
+
+	CVT_STANDARD_BLANKING = 0 // CVT standard blanking
+	CVT_REDUCED_BLANKING  = 1 // CVT reduced blanking
+	CVT_REDUCED_BLANKING2 = 2 // CVT reduced blanking version 2
+	CVT_REDUCED_BLANKING3 = 3 // CVT reduced blanking version 3
+
+	DISPLAYID_PRODUCT_ID_MIN_LEN     = 12   // Vendor, product code, serial, date and name length
+	DISPLAYID_PARAMS_LEGACY_SIZE     = 12   // DisplayID 1.3 display parameters payload size
+	DISPLAYID_PARAMS_SIZE            = 29   // DisplayID 2.x display parameters payload size
+	DISPLAYID_PARAMS_SIZE_MM         = 0x80 // Image size is in 1 mm instead of 0.1 mm steps
+	DISPLAYID_PARAMS_SCAN_MASK       = 0x07 // Scan orientation
+	DISPLAYID_PARAMS_LUMINANCE_MASK  = 0x18 // Luminance information
+	DISPLAYID_PARAMS_NO_AUDIO        = 0x40 // Audio speakers are not integrated
+	DISPLAYID_PARAMS_CIE_1976        = 0x80 // Color coordinates are CIE 1976
+	DISPLAYID_PARAMS_BIT_DEPTH_MASK  = 0x07 // Color bit depth
+	DISPLAYID_PARAMS_TECHNOLOGY_MASK = 0x70 // Display device technology
+	DISPLAYID_SCALED_UNDEFINED       = 0xFF // Gamma or aspect ratio not specified
+	DISPLAYID_MODEL_YEAR             = 0xFF // Week of manufacture holds a model year
+
+	DISPLAYID_INTERFACE_FEATURES_MIN_LEN  = 9     // Interface features payload without additional combinations
+	DISPLAYID_INTERFACE_COMBINATIONS      = 0x07  // Number of additional color space and EOTF combinations
+	DISPLAYID_INTERFACE_MIN_420_RATE      = 74.25 // Minimum 4:2:0 pixel rate unit in MHz
+	DISPLAYID_STEREO_MIN_LEN              = 2     // Method parameter length and method code
+	DISPLAYID_STEREO_TIMING_SUPPORT       = 0xC0  // Stereo timing support
+	DISPLAYID_STEREO_HAS_CODES            = 0x40  // Stereo block lists timing codes
+	DISPLAYID_STEREO_CODE_TYPE_MASK       = 0xC0  // Stereo timing code type
+	DISPLAYID_STEREO_CODE_COUNT_MASK      = 0x1F  // Number of stereo timing codes
+	DISPLAYID_CONTAINER_ID_SIZE           = 16    // ContainerID UUID size
+	DISPLAYID_ADAPTIVE_SYNC_SIZE          = 6     // Adaptive sync descriptor size without additional bytes
+	DISPLAYID_ADAPTIVE_SYNC_EXTRA_MASK    = 0x70  // Additional bytes per adaptive sync descriptor
+	DISPLAYID_ADAPTIVE_SYNC_NATIVE        = 0x01  // Native panel range
+	DISPLAYID_ADAPTIVE_SYNC_VTOTAL_MASK   = 0x0C  // Fixed average and adaptive V-Total support
+	DISPLAYID_ADAPTIVE_SYNC_SEAMLESS      = 0x10  // Seamless transition
+	DISPLAYID_ADAPTIVE_SYNC_INC_NO_JITTER = 0x20  // Max duration increase without jitter impact
+	DISPLAYID_ADAPTIVE_SYNC_DEC_NO_JITTER = 0x40  // Max duration decrease without jitter impact
+	DISPLAYID_ADAPTIVE_SYNC_MAX_MSB_MASK  = 0x03  // Max refresh rate bits 9:8
+	DISPLAYID_OUI_SIZE                    = 3     // Vendor-specific block OUI size
+
+	DISPLAYID_COLOR_CIE_1976          = 0x80 // Color characteristics use CIE 1976 coordinates
+	DISPLAYID_COLOR_TRANSFER_ID_MASK  = 0x78 // Associated transfer characteristics identifier
+	DISPLAYID_COLOR_TEMPORAL          = 0x80 // Temporal instead of spatial color
+	DISPLAYID_COLOR_PRIMARIES_MASK    = 0x70 // Number of color primaries
+	DISPLAYID_COLOR_WHITE_POINTS_MASK = 0x0F // Number of white points
+	DISPLAYID_TYPE_2_HSYNC_POSITIVE   = 0x08 // Type 2 horizontal sync polarity
+	DISPLAYID_TYPE_2_VSYNC_POSITIVE   = 0x04 // Type 2 vertical sync polarity
+	DISPLAYID_TYPE_3_FORMULA_MASK     = 0x70 // Type 3 timing formula
+	DISPLAYID_TYPE_3_INTERLACED       = 0x80 // Type 3 interlaced timing
+	DISPLAYID_TYPE_3_REFRESH_MASK     = 0x7F // Type 3 refresh rate
+	DISPLAYID_TYPE_4_CODE_MASK        = 0xC0 // Type 4 timing code type
+	DISPLAYID_VESA_TIMINGS_SIZE       = 10   // VESA timing bitmap size
+	DISPLAYID_CTA_TIMINGS_SIZE        = 8    // CTA-861 timing bitmap size
+	DISPLAYID_RANGE_LIMITS_SIZE       = 15   // Video timing range limits payload size
+	DISPLAYID_RANGE_INTERLACED        = 0x80 // Interlaced timings supported
+	DISPLAYID_RANGE_CVT               = 0x40 // CVT standard blanking supported
+	DISPLAYID_RANGE_CVT_RB            = 0x20 // CVT reduced blanking supported
+	DISPLAYID_RANGE_DISCRETE          = 0x10 // Discrete frequency display device
+	DISPLAYID_POWER_SEQUENCING_SIZE   = 6    // Power sequencing payload size
+	DISPLAYID_TRANSFER_ID_MASK        = 0xF0 // Transfer characteristics identifier
+	DISPLAYID_TRANSFER_WHITE_FIRST    = 0x80 // First curve is the white curve
+	DISPLAYID_TRANSFER_INDIVIDUAL     = 0x40 // Individual curves per primary
+	DISPLAYID_TRANSFER_PARAMETERS     = 0x20 // Curves use four parameters and gamma
+	DISPLAYID_TRANSFER_MAX_SAMPLE     = 1023 // Full scale of the accumulated curve samples
+	DISPLAYID_INTERFACE_SIZE          = 10   // Display interface legacy payload size
+	DISPLAYID_INTERFACE_TYPE_MASK     = 0xF0 // Interface type
+	DISPLAYID_INTERFACE_CHANNELS_MASK = 0x0F // Number of channels or analog subtype
+	DISPLAYID_SPREAD_SPECTRUM_MASK    = 0xC0 // Spread spectrum type
+	DISPLAYID_SPREAD_PERCENT_MASK     = 0x0F // Spread spectrum percentage in 0.1% steps
+
+	DISPLAYID_SECTION_HEADER_SIZE = 4    // Revision, bytes in section, product type and extension count
+	DISPLAYID_BLOCK_HEADER_SIZE   = 3    // Tag, revision and number of payload bytes
+	DISPLAYID_MAX_SECTION_PAYLOAD = 251  // Maximum number of bytes in a section
+	DISPLAYID_VERSION_1_3         = 0x13 // DisplayID 1.3
+	DISPLAYID_VERSION_2_0         = 0x20 // DisplayID 2.0
+	DISPLAYID_VERSION_2_1         = 0x21 // DisplayID 2.1
+	DISPLAYID_TIMING_PREFERRED    = 0x80 // Preferred timing option
+	DISPLAYID_TIMING_STEREO_MASK  = 0x60 // 3D stereo support
+	DISPLAYID_TIMING_INTERLACED   = 0x10 // Interlaced timing
+	DISPLAYID_TIMING_ASPECT_MASK  = 0x0F // Aspect ratio
+	DISPLAYID_TYPE_7_EXTRA_MASK   = 0x70 // Additional bytes per type 7 descriptor
+	DISPLAYID_TYPE_8_CODE_MASK    = 0xC0 // Type 8 timing code type
+	DISPLAYID_TYPE_8_2BYTE_CODES  = 0x08 // Type 8 timing codes are 2 bytes
+	DISPLAYID_TYPE_10_EXTRA_MASK  = 0x70 // Additional bytes per type 10 descriptor
+	DISPLAYID_FORMULA_MASK        = 0x07 // Type 9 and 10 timing formula
+	DISPLAYID_FORMULA_FLAG        = 0x10 // 1000/1001 refresh rate support, 160 pixel blanking for CVT-RB3
+	DISPLAYID_CODE_DMT            = 0x00 // DMT ID timing codes
+	DISPLAYID_CODE_CTA            = 0x01 // CTA-861 VIC timing codes
+	DISPLAYID_CODE_HDMI           = 0x02 // HDMI VIC timing codes
 
 	TILE_ONE_TILE_BEHAVIOR  = 0x07 // One tile behavior
 	TILE_N_TILE_BEHAVIOR    = 0x18 // N tile behavior
 	TILE_BEZEL_DESCRIPTOR   = 0x40 // Bezel descriptor
 	TILE_PHYSICAL_ENCLOSURE = 0x80 // Physical enclosure
+	TILE_H_TILES_MSB        = 0xC0 // Number of horizontal tiles bits 5:4
+	TILE_V_TILES_MSB        = 0x30 // Number of vertical tiles bits 5:4
+	TILE_H_LOCATION_MSB     = 0x0C // Horizontal tile location bits 5:4
+	TILE_V_LOCATION_MSB     = 0x03 // Vertical tile location bits 5:4
+	TILE_MAX_TILES          = 64   // Maximum number of tiles in each direction
 
 )
 
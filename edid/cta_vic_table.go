@@ -0,0 +1,200 @@
+package edid
+
+type ctaVIC struct {
+	pixelClock    float64 // pixel clock in MHz
+	hActive       int     // horizontal addressable pixels
+	hFrontPorch   int     // horizontal front porch pixels
+	hSyncWidth    int     // horizontal sync pulse width pixels
+	hBackPorch    int     // horizontal back porch pixels
+	vActive       int     // vertical addressable lines, per field for interlaced formats
+	vFrontPorch   int     // vertical front porch lines
+	vSyncWidth    int     // vertical sync pulse width lines
+	vBackPorch    int     // vertical back porch lines
+	hSyncPositive bool    // horizontal sync polarity is positive
+	vSyncPositive bool    // vertical sync polarity is positive
+	interlaced    bool    // interlaced format
+	aspectRatio   string  // picture aspect ratio
+	equalFields   bool    // interlaced fields have the same number of lines
+}
+
+// CTA-861 video identification codes, VIC 1-127 and 193-219. Pixel repeated
+// formats are listed with their transmitted (repeated) horizontal timing.
+var ctaVICTable = map[int]ctaVIC{
+	1:   {25.175, 640, 16, 96, 48, 480, 10, 2, 33, false, false, false, "4:3", false},        // 640x480p @ 59.94Hz
+	2:   {27, 720, 16, 62, 60, 480, 9, 6, 30, false, false, false, "4:3", false},             // 720x480p @ 59.94Hz
+	3:   {27, 720, 16, 62, 60, 480, 9, 6, 30, false, false, false, "16:9", false},            // 720x480p @ 59.94Hz
+	4:   {74.25, 1280, 110, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},        // 1280x720p @ 60.00Hz
+	5:   {74.25, 1920, 88, 44, 148, 540, 2, 5, 15, true, true, true, "16:9", false},          // 1920x1080i @ 60.00Hz
+	6:   {27, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, true, "4:3", false},           // 1440x480i @ 59.94Hz
+	7:   {27, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, true, "16:9", false},          // 1440x480i @ 59.94Hz
+	8:   {27, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, false, "4:3", false},          // 1440x240p @ 60.05Hz
+	9:   {27, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, false, "16:9", false},         // 1440x240p @ 60.05Hz
+	10:  {54, 2880, 76, 248, 228, 240, 4, 3, 15, false, false, true, "4:3", false},           // 2880x480i @ 59.94Hz
+	11:  {54, 2880, 76, 248, 228, 240, 4, 3, 15, false, false, true, "16:9", false},          // 2880x480i @ 59.94Hz
+	12:  {54, 2880, 76, 248, 228, 240, 4, 3, 15, false, false, false, "4:3", false},          // 2880x240p @ 60.05Hz
+	13:  {54, 2880, 76, 248, 228, 240, 4, 3, 15, false, false, false, "16:9", false},         // 2880x240p @ 60.05Hz
+	14:  {54, 1440, 32, 124, 120, 480, 9, 6, 30, false, false, false, "4:3", false},          // 1440x480p @ 59.94Hz
+	15:  {54, 1440, 32, 124, 120, 480, 9, 6, 30, false, false, false, "16:9", false},         // 1440x480p @ 59.94Hz
+	16:  {148.5, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},        // 1920x1080p @ 60.00Hz
+	17:  {27, 720, 12, 64, 68, 576, 5, 5, 39, false, false, false, "4:3", false},             // 720x576p @ 50.00Hz
+	18:  {27, 720, 12, 64, 68, 576, 5, 5, 39, false, false, false, "16:9", false},            // 720x576p @ 50.00Hz
+	19:  {74.25, 1280, 440, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},        // 1280x720p @ 50.00Hz
+	20:  {74.25, 1920, 528, 44, 148, 540, 2, 5, 15, true, true, true, "16:9", false},         // 1920x1080i @ 50.00Hz
+	21:  {27, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, true, "4:3", false},           // 1440x576i @ 50.00Hz
+	22:  {27, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, true, "16:9", false},          // 1440x576i @ 50.00Hz
+	23:  {27, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, false, "4:3", false},          // 1440x288p @ 50.08Hz
+	24:  {27, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, false, "16:9", false},         // 1440x288p @ 50.08Hz
+	25:  {54, 2880, 48, 252, 276, 288, 2, 3, 19, false, false, true, "4:3", false},           // 2880x576i @ 50.00Hz
+	26:  {54, 2880, 48, 252, 276, 288, 2, 3, 19, false, false, true, "16:9", false},          // 2880x576i @ 50.00Hz
+	27:  {54, 2880, 48, 252, 276, 288, 2, 3, 19, false, false, false, "4:3", false},          // 2880x288p @ 50.08Hz
+	28:  {54, 2880, 48, 252, 276, 288, 2, 3, 19, false, false, false, "16:9", false},         // 2880x288p @ 50.08Hz
+	29:  {54, 1440, 24, 128, 136, 576, 5, 5, 39, false, true, false, "4:3", false},           // 1440x576p @ 50.00Hz
+	30:  {54, 1440, 24, 128, 136, 576, 5, 5, 39, false, true, false, "16:9", false},          // 1440x576p @ 50.00Hz
+	31:  {148.5, 1920, 528, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},       // 1920x1080p @ 50.00Hz
+	32:  {74.25, 1920, 638, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},       // 1920x1080p @ 24.00Hz
+	33:  {74.25, 1920, 528, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},       // 1920x1080p @ 25.00Hz
+	34:  {74.25, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},        // 1920x1080p @ 30.00Hz
+	35:  {108, 2880, 64, 248, 240, 480, 9, 6, 30, false, false, false, "4:3", false},         // 2880x480p @ 59.94Hz
+	36:  {108, 2880, 64, 248, 240, 480, 9, 6, 30, false, false, false, "16:9", false},        // 2880x480p @ 59.94Hz
+	37:  {108, 2880, 48, 256, 272, 576, 5, 5, 39, false, false, false, "4:3", false},         // 2880x576p @ 50.00Hz
+	38:  {108, 2880, 48, 256, 272, 576, 5, 5, 39, false, false, false, "16:9", false},        // 2880x576p @ 50.00Hz
+	39:  {72, 1920, 32, 168, 184, 540, 23, 5, 57, true, false, true, "16:9", true},           // 1920x1080i @ 50.00Hz
+	40:  {148.5, 1920, 528, 44, 148, 540, 2, 5, 15, true, true, true, "16:9", false},         // 1920x1080i @ 100.00Hz
+	41:  {148.5, 1280, 440, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},        // 1280x720p @ 100.00Hz
+	42:  {54, 720, 12, 64, 68, 576, 5, 5, 39, false, false, false, "4:3", false},             // 720x576p @ 100.00Hz
+	43:  {54, 720, 12, 64, 68, 576, 5, 5, 39, false, false, false, "16:9", false},            // 720x576p @ 100.00Hz
+	44:  {54, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, true, "4:3", false},           // 1440x576i @ 100.00Hz
+	45:  {54, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, true, "16:9", false},          // 1440x576i @ 100.00Hz
+	46:  {148.5, 1920, 88, 44, 148, 540, 2, 5, 15, true, true, true, "16:9", false},          // 1920x1080i @ 120.00Hz
+	47:  {148.5, 1280, 110, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},        // 1280x720p @ 120.00Hz
+	48:  {54, 720, 16, 62, 60, 480, 9, 6, 30, false, false, false, "4:3", false},             // 720x480p @ 119.88Hz
+	49:  {54, 720, 16, 62, 60, 480, 9, 6, 30, false, false, false, "16:9", false},            // 720x480p @ 119.88Hz
+	50:  {54, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, true, "4:3", false},           // 1440x480i @ 119.88Hz
+	51:  {54, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, true, "16:9", false},          // 1440x480i @ 119.88Hz
+	52:  {108, 720, 12, 64, 68, 576, 5, 5, 39, false, false, false, "4:3", false},            // 720x576p @ 200.00Hz
+	53:  {108, 720, 12, 64, 68, 576, 5, 5, 39, false, false, false, "16:9", false},           // 720x576p @ 200.00Hz
+	54:  {108, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, true, "4:3", false},          // 1440x576i @ 200.00Hz
+	55:  {108, 1440, 24, 126, 138, 288, 2, 3, 19, false, false, true, "16:9", false},         // 1440x576i @ 200.00Hz
+	56:  {108, 720, 16, 62, 60, 480, 9, 6, 30, false, false, false, "4:3", false},            // 720x480p @ 239.76Hz
+	57:  {108, 720, 16, 62, 60, 480, 9, 6, 30, false, false, false, "16:9", false},           // 720x480p @ 239.76Hz
+	58:  {108, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, true, "4:3", false},          // 1440x480i @ 239.76Hz
+	59:  {108, 1440, 38, 124, 114, 240, 4, 3, 15, false, false, true, "16:9", false},         // 1440x480i @ 239.76Hz
+	60:  {59.4, 1280, 1760, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},        // 1280x720p @ 24.00Hz
+	61:  {74.25, 1280, 2420, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},       // 1280x720p @ 25.00Hz
+	62:  {74.25, 1280, 1760, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},       // 1280x720p @ 30.00Hz
+	63:  {297, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},          // 1920x1080p @ 120.00Hz
+	64:  {297, 1920, 528, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},         // 1920x1080p @ 100.00Hz
+	65:  {59.4, 1280, 1760, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1280x720p @ 24.00Hz
+	66:  {74.25, 1280, 2420, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},      // 1280x720p @ 25.00Hz
+	67:  {74.25, 1280, 1760, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},      // 1280x720p @ 30.00Hz
+	68:  {74.25, 1280, 440, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1280x720p @ 50.00Hz
+	69:  {74.25, 1280, 110, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1280x720p @ 60.00Hz
+	70:  {148.5, 1280, 440, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1280x720p @ 100.00Hz
+	71:  {148.5, 1280, 110, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1280x720p @ 120.00Hz
+	72:  {74.25, 1920, 638, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},      // 1920x1080p @ 24.00Hz
+	73:  {74.25, 1920, 528, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},      // 1920x1080p @ 25.00Hz
+	74:  {74.25, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},       // 1920x1080p @ 30.00Hz
+	75:  {148.5, 1920, 528, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},      // 1920x1080p @ 50.00Hz
+	76:  {148.5, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},       // 1920x1080p @ 60.00Hz
+	77:  {297, 1920, 528, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},        // 1920x1080p @ 100.00Hz
+	78:  {297, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},         // 1920x1080p @ 120.00Hz
+	79:  {59.4, 1680, 1360, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1680x720p @ 24.00Hz
+	80:  {59.4, 1680, 1228, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},       // 1680x720p @ 25.00Hz
+	81:  {59.4, 1680, 700, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},        // 1680x720p @ 30.00Hz
+	82:  {82.5, 1680, 260, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},        // 1680x720p @ 50.00Hz
+	83:  {99, 1680, 260, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},          // 1680x720p @ 60.00Hz
+	84:  {165, 1680, 60, 40, 220, 720, 5, 5, 95, true, true, false, "64:27", false},          // 1680x720p @ 100.00Hz
+	85:  {198, 1680, 60, 40, 220, 720, 5, 5, 95, true, true, false, "64:27", false},          // 1680x720p @ 120.00Hz
+	86:  {99, 2560, 998, 44, 148, 1080, 4, 5, 11, true, true, false, "64:27", false},         // 2560x1080p @ 24.00Hz
+	87:  {90, 2560, 448, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},         // 2560x1080p @ 25.00Hz
+	88:  {118.8, 2560, 768, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},      // 2560x1080p @ 30.00Hz
+	89:  {185.625, 2560, 548, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},    // 2560x1080p @ 50.00Hz
+	90:  {198, 2560, 248, 44, 148, 1080, 4, 5, 11, true, true, false, "64:27", false},        // 2560x1080p @ 60.00Hz
+	91:  {371.25, 2560, 218, 44, 148, 1080, 4, 5, 161, true, true, false, "64:27", false},    // 2560x1080p @ 100.00Hz
+	92:  {495, 2560, 548, 44, 148, 1080, 4, 5, 161, true, true, false, "64:27", false},       // 2560x1080p @ 120.00Hz
+	93:  {297, 3840, 1276, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},       // 3840x2160p @ 24.00Hz
+	94:  {297, 3840, 1056, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},       // 3840x2160p @ 25.00Hz
+	95:  {297, 3840, 176, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},        // 3840x2160p @ 30.00Hz
+	96:  {594, 3840, 1056, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},       // 3840x2160p @ 50.00Hz
+	97:  {594, 3840, 176, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},        // 3840x2160p @ 60.00Hz
+	98:  {297, 4096, 1020, 88, 296, 2160, 8, 10, 72, true, true, false, "256:135", false},    // 4096x2160p @ 24.00Hz
+	99:  {297, 4096, 968, 88, 128, 2160, 8, 10, 72, true, true, false, "256:135", false},     // 4096x2160p @ 25.00Hz
+	100: {297, 4096, 88, 88, 128, 2160, 8, 10, 72, true, true, false, "256:135", false},      // 4096x2160p @ 30.00Hz
+	101: {594, 4096, 968, 88, 128, 2160, 8, 10, 72, true, true, false, "256:135", false},     // 4096x2160p @ 50.00Hz
+	102: {594, 4096, 88, 88, 128, 2160, 8, 10, 72, true, true, false, "256:135", false},      // 4096x2160p @ 60.00Hz
+	103: {297, 3840, 1276, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},      // 3840x2160p @ 24.00Hz
+	104: {297, 3840, 1056, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},      // 3840x2160p @ 25.00Hz
+	105: {297, 3840, 176, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},       // 3840x2160p @ 30.00Hz
+	106: {594, 3840, 1056, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},      // 3840x2160p @ 50.00Hz
+	107: {594, 3840, 176, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},       // 3840x2160p @ 60.00Hz
+	108: {90, 1280, 960, 40, 220, 720, 5, 5, 20, true, true, false, "16:9", false},           // 1280x720p @ 48.00Hz
+	109: {90, 1280, 960, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},          // 1280x720p @ 48.00Hz
+	110: {99, 1680, 810, 40, 220, 720, 5, 5, 20, true, true, false, "64:27", false},          // 1680x720p @ 48.00Hz
+	111: {148.5, 1920, 638, 44, 148, 1080, 4, 5, 36, true, true, false, "16:9", false},       // 1920x1080p @ 48.00Hz
+	112: {148.5, 1920, 638, 44, 148, 1080, 4, 5, 36, true, true, false, "64:27", false},      // 1920x1080p @ 48.00Hz
+	113: {198, 2560, 998, 44, 148, 1080, 4, 5, 11, true, true, false, "64:27", false},        // 2560x1080p @ 48.00Hz
+	114: {594, 3840, 1276, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},       // 3840x2160p @ 48.00Hz
+	115: {594, 4096, 1020, 88, 296, 2160, 8, 10, 72, true, true, false, "256:135", false},    // 4096x2160p @ 48.00Hz
+	116: {594, 3840, 1276, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},      // 3840x2160p @ 48.00Hz
+	117: {1188, 3840, 1056, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},      // 3840x2160p @ 100.00Hz
+	118: {1188, 3840, 176, 88, 296, 2160, 8, 10, 72, true, true, false, "16:9", false},       // 3840x2160p @ 120.00Hz
+	119: {1188, 3840, 1056, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},     // 3840x2160p @ 100.00Hz
+	120: {1188, 3840, 176, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},      // 3840x2160p @ 120.00Hz
+	121: {396, 5120, 1996, 88, 296, 2160, 8, 10, 22, true, true, false, "64:27", false},      // 5120x2160p @ 24.00Hz
+	122: {396, 5120, 1696, 88, 296, 2160, 8, 10, 22, true, true, false, "64:27", false},      // 5120x2160p @ 25.00Hz
+	123: {396, 5120, 664, 88, 128, 2160, 8, 10, 22, true, true, false, "64:27", false},       // 5120x2160p @ 30.00Hz
+	124: {742.5, 5120, 746, 88, 296, 2160, 8, 10, 297, true, true, false, "64:27", false},    // 5120x2160p @ 48.00Hz
+	125: {742.5, 5120, 1096, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},    // 5120x2160p @ 50.00Hz
+	126: {742.5, 5120, 164, 88, 128, 2160, 8, 10, 72, true, true, false, "64:27", false},     // 5120x2160p @ 60.00Hz
+	127: {1485, 5120, 1096, 88, 296, 2160, 8, 10, 72, true, true, false, "64:27", false},     // 5120x2160p @ 100.00Hz
+	193: {1485, 5120, 164, 88, 128, 2160, 8, 10, 72, true, true, false, "64:27", false},      // 5120x2160p @ 120.00Hz
+	194: {1188, 7680, 2552, 176, 592, 4320, 16, 20, 144, true, true, false, "16:9", false},   // 7680x4320p @ 24.00Hz
+	195: {1188, 7680, 2352, 176, 592, 4320, 16, 20, 44, true, true, false, "16:9", false},    // 7680x4320p @ 25.00Hz
+	196: {1188, 7680, 552, 176, 592, 4320, 16, 20, 44, true, true, false, "16:9", false},     // 7680x4320p @ 30.00Hz
+	197: {2376, 7680, 2552, 176, 592, 4320, 16, 20, 144, true, true, false, "16:9", false},   // 7680x4320p @ 48.00Hz
+	198: {2376, 7680, 2352, 176, 592, 4320, 16, 20, 44, true, true, false, "16:9", false},    // 7680x4320p @ 50.00Hz
+	199: {2376, 7680, 552, 176, 592, 4320, 16, 20, 44, true, true, false, "16:9", false},     // 7680x4320p @ 60.00Hz
+	200: {4752, 7680, 2112, 176, 592, 4320, 16, 20, 144, true, true, false, "16:9", false},   // 7680x4320p @ 100.00Hz
+	201: {4752, 7680, 352, 176, 592, 4320, 16, 20, 144, true, true, false, "16:9", false},    // 7680x4320p @ 120.00Hz
+	202: {1188, 7680, 2552, 176, 592, 4320, 16, 20, 144, true, true, false, "64:27", false},  // 7680x4320p @ 24.00Hz
+	203: {1188, 7680, 2352, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},   // 7680x4320p @ 25.00Hz
+	204: {1188, 7680, 552, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},    // 7680x4320p @ 30.00Hz
+	205: {2376, 7680, 2552, 176, 592, 4320, 16, 20, 144, true, true, false, "64:27", false},  // 7680x4320p @ 48.00Hz
+	206: {2376, 7680, 2352, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},   // 7680x4320p @ 50.00Hz
+	207: {2376, 7680, 552, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},    // 7680x4320p @ 60.00Hz
+	208: {4752, 7680, 2112, 176, 592, 4320, 16, 20, 144, true, true, false, "64:27", false},  // 7680x4320p @ 100.00Hz
+	209: {4752, 7680, 352, 176, 592, 4320, 16, 20, 144, true, true, false, "64:27", false},   // 7680x4320p @ 120.00Hz
+	210: {1485, 10240, 1472, 176, 612, 4320, 16, 20, 594, true, true, false, "64:27", false}, // 10240x4320p @ 24.00Hz
+	211: {1485, 10240, 2492, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},  // 10240x4320p @ 25.00Hz
+	212: {1485, 10240, 288, 176, 296, 4320, 16, 20, 144, true, true, false, "64:27", false},  // 10240x4320p @ 30.00Hz
+	213: {2970, 10240, 1472, 176, 612, 4320, 16, 20, 594, true, true, false, "64:27", false}, // 10240x4320p @ 48.00Hz
+	214: {2970, 10240, 2492, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},  // 10240x4320p @ 50.00Hz
+	215: {2970, 10240, 288, 176, 296, 4320, 16, 20, 144, true, true, false, "64:27", false},  // 10240x4320p @ 60.00Hz
+	216: {5940, 10240, 2492, 176, 592, 4320, 16, 20, 44, true, true, false, "64:27", false},  // 10240x4320p @ 100.00Hz
+	217: {5940, 10240, 288, 176, 296, 4320, 16, 20, 144, true, true, false, "64:27", false},  // 10240x4320p @ 120.00Hz
+	218: {1188, 4096, 800, 88, 296, 2160, 8, 10, 72, true, true, false, "256:135", false},    // 4096x2160p @ 100.00Hz
+	219: {1188, 4096, 88, 88, 128, 2160, 8, 10, 72, true, true, false, "256:135", false},     // 4096x2160p @ 120.00Hz
+}
+
+func (vic ctaVIC) timing() Timing {
+	t := Timing{
+		PixelClock:             vic.pixelClock,
+		HorizontalActive:       vic.hActive,
+		HorizontalBlanking:     vic.hFrontPorch + vic.hSyncWidth + vic.hBackPorch,
+		HorizontalFrontPorch:   vic.hFrontPorch,
+		HorizontalSyncWidth:    vic.hSyncWidth,
+		HorizontalSyncPositive: vic.hSyncPositive,
+		VerticalActive:         vic.vActive,
+		VerticalBlanking:       vic.vFrontPorch + vic.vSyncWidth + vic.vBackPorch,
+		VerticalFrontPorch:     vic.vFrontPorch,
+		VerticalSyncWidth:      vic.vSyncWidth,
+		VerticalSyncPositive:   vic.vSyncPositive,
+		Interlaced:             vic.interlaced,
+	}
+	t.RefreshRate = t.refreshRate()
+	// Both fields hold vActive + vBlanking lines, there is no extra half line
+	if vic.equalFields {
+		t.RefreshRate = t.PixelClock * 1000 * 1000 / float64((t.HorizontalActive+t.HorizontalBlanking)*(t.VerticalActive+t.VerticalBlanking))
+	}
+	return t
+}
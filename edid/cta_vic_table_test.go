@@ -0,0 +1,29 @@
+package edid
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+// TestCTAVICTableRefreshRates checks every VIC timing against the nominal
+// format in the comment of its table row
+func TestCTAVICTableRefreshRates(t *testing.T) {
+	source, err := os.ReadFile("cta_vic_table.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := regexp.MustCompile(`(?m)^\t(\d+): +\{.*\}, +// (\d+x\d+[pi] @ [\d.]+Hz)$`)
+	rows := row.FindAllStringSubmatch(string(source), -1)
+	if len(rows) != len(ctaVICTable) {
+		t.Fatalf("found %d commented rows, the table holds %d VICs", len(rows), len(ctaVICTable))
+	}
+	for _, r := range rows {
+		vic, _ := strconv.Atoi(r[1])
+		timing := ctaVICTable[vic].timing()
+		if got := timingString(timing); got != r[2] {
+			t.Errorf("VIC %d: got %s, want %s", vic, got, r[2])
+		}
+	}
+}
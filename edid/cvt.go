@@ -0,0 +1,97 @@
+package edid
+
+import (
+	"math"
+)
+
+// CVT vertical sync widths depend on the aspect ratio for standard and
+// reduced blanking version 1 timings
+func cvtVSyncWidth(hActive, vActive int) int {
+	switch {
+	case hActive*3 == vActive*4:
+		return 4
+	case hActive*9 == vActive*16:
+		return 5
+	case hActive*10 == vActive*16:
+		return 6
+	case hActive*4 == vActive*5, hActive*9 == vActive*15:
+		return 7
+	default:
+		return 10
+	}
+}
+
+// cvtTiming expands a progressive resolution and refresh rate into a full
+// timing using the VESA Coordinated Video Timings formula. The horizontal
+// blanking of reduced blanking version 3 timings is 160 pixels when
+// wideHBlank is set and 80 pixels otherwise.
+func cvtTiming(hActive, vActive int, refreshRate float64, blanking int, wideHBlank bool) Timing {
+	var t Timing
+	t.VerticalActive = vActive
+
+	switch blanking {
+	case CVT_STANDARD_BLANKING:
+		t.HorizontalActive = hActive / 8 * 8
+		t.VerticalSyncWidth = cvtVSyncWidth(hActive, vActive)
+		t.VerticalFrontPorch = 3
+
+		// Minimum vertical sync + back porch of 550 us
+		hPeriod := (1000000.0/refreshRate - 550.0) / float64(vActive+t.VerticalFrontPorch)
+		vSyncBackPorch := int(550.0/hPeriod) + 1
+		if vSyncBackPorch < t.VerticalSyncWidth+6 {
+			vSyncBackPorch = t.VerticalSyncWidth + 6
+		}
+		t.VerticalBlanking = t.VerticalFrontPorch + vSyncBackPorch
+
+		dutyCycle := 30.0 - 300.0*hPeriod/1000.0
+		if dutyCycle < 20.0 {
+			dutyCycle = 20.0
+		}
+		t.HorizontalBlanking = int(float64(t.HorizontalActive)*dutyCycle/(100.0-dutyCycle)/16.0) * 16
+		total := t.HorizontalActive + t.HorizontalBlanking
+		t.PixelClock = 0.25 * math.Floor(float64(total)/hPeriod/0.25)
+		t.HorizontalSyncWidth = int(0.08*float64(total)/8.0) * 8
+		t.HorizontalFrontPorch = t.HorizontalBlanking/2 - t.HorizontalSyncWidth
+		t.VerticalSyncPositive = true
+	case CVT_REDUCED_BLANKING:
+		t.HorizontalActive = hActive / 8 * 8
+		t.HorizontalBlanking = 160
+		t.HorizontalFrontPorch = 48
+		t.HorizontalSyncWidth = 32
+		t.VerticalSyncWidth = cvtVSyncWidth(hActive, vActive)
+		t.VerticalFrontPorch = 3
+
+		// Minimum vertical blanking of 460 us
+		hPeriod := (1000000.0/refreshRate - 460.0) / float64(vActive)
+		t.VerticalBlanking = int(460.0/hPeriod) + 1
+		if t.VerticalBlanking < t.VerticalFrontPorch+t.VerticalSyncWidth+6 {
+			t.VerticalBlanking = t.VerticalFrontPorch + t.VerticalSyncWidth + 6
+		}
+		total := float64((t.HorizontalActive + t.HorizontalBlanking) * (vActive + t.VerticalBlanking))
+		t.PixelClock = 0.25 * math.Floor(refreshRate*total/1000000.0/0.25)
+		t.HorizontalSyncPositive = true
+	default:
+		t.HorizontalActive = hActive
+		t.HorizontalBlanking = 80
+		if blanking == CVT_REDUCED_BLANKING3 && wideHBlank {
+			t.HorizontalBlanking = 160
+		}
+		t.HorizontalFrontPorch = 8
+		t.HorizontalSyncWidth = 32
+		t.VerticalSyncWidth = 8
+
+		// Minimum vertical blanking of 460 us with a fixed back porch of 6 lines
+		hPeriod := (1000000.0/refreshRate - 460.0) / float64(vActive)
+		t.VerticalBlanking = int(460.0/hPeriod) + 1
+		if t.VerticalBlanking < 1+t.VerticalSyncWidth+6 {
+			t.VerticalBlanking = 1 + t.VerticalSyncWidth + 6
+		}
+		t.VerticalFrontPorch = t.VerticalBlanking - t.VerticalSyncWidth - 6
+		total := float64((t.HorizontalActive + t.HorizontalBlanking) * (vActive + t.VerticalBlanking))
+		t.PixelClock = 0.001 * math.Floor(refreshRate*total/1000000.0/0.001)
+		t.HorizontalSyncPositive = true
+	}
+
+	t.RefreshRate = t.refreshRate()
+	return t
+}
@@ -0,0 +1,158 @@
+package edid
+
+type dmtTiming struct {
+	id              int     // DMT ID
+	refreshRate     int     // nominal vertical refresh rate in Hz
+	reducedBlanking bool    // CVT reduced blanking timing
+	pixelClock      float64 // pixel clock in MHz
+	hActive         int     // horizontal addressable pixels
+	hFrontPorch     int     // horizontal front porch pixels
+	hSyncWidth      int     // horizontal sync pulse width pixels
+	hBackPorch      int     // horizontal back porch pixels
+	vActive         int     // vertical addressable lines, per field for interlaced timings
+	vFrontPorch     int     // vertical front porch lines
+	vSyncWidth      int     // vertical sync pulse width lines
+	vBackPorch      int     // vertical back porch lines
+	hSyncPositive   bool    // horizontal sync polarity is positive
+	vSyncPositive   bool    // vertical sync polarity is positive
+	interlaced      bool    // interlaced timing
+}
+
+// VESA Display Monitor Timings, DMT ID 0x01-0x58
+var dmtTable = []dmtTiming{
+	{0x01, 85, false, 31.5, 640, 32, 64, 96, 350, 32, 3, 60, true, false, false},       // 640x350p @ 85Hz
+	{0x02, 85, false, 31.5, 640, 32, 64, 96, 400, 1, 3, 41, false, true, false},        // 640x400p @ 85Hz
+	{0x03, 85, false, 35.5, 720, 36, 72, 108, 400, 1, 3, 42, false, true, false},       // 720x400p @ 85Hz
+	{0x04, 60, false, 25.175, 640, 16, 96, 48, 480, 10, 2, 33, false, false, false},    // 640x480p @ 60Hz
+	{0x05, 72, false, 31.5, 640, 24, 40, 128, 480, 9, 3, 28, false, false, false},      // 640x480p @ 72Hz
+	{0x06, 75, false, 31.5, 640, 16, 64, 120, 480, 1, 3, 16, false, false, false},      // 640x480p @ 75Hz
+	{0x07, 85, false, 36, 640, 56, 56, 80, 480, 1, 3, 25, false, false, false},         // 640x480p @ 85Hz
+	{0x08, 56, false, 36, 800, 24, 72, 128, 600, 1, 2, 22, true, true, false},          // 800x600p @ 56Hz
+	{0x09, 60, false, 40, 800, 40, 128, 88, 600, 1, 4, 23, true, true, false},          // 800x600p @ 60Hz
+	{0x0A, 72, false, 50, 800, 56, 120, 64, 600, 37, 6, 23, true, true, false},         // 800x600p @ 72Hz
+	{0x0B, 75, false, 49.5, 800, 16, 80, 160, 600, 1, 3, 21, true, true, false},        // 800x600p @ 75Hz
+	{0x0C, 85, false, 56.25, 800, 32, 64, 152, 600, 1, 3, 27, true, true, false},       // 800x600p @ 85Hz
+	{0x0D, 120, true, 73.25, 800, 48, 32, 80, 600, 3, 4, 29, true, false, false},       // 800x600p @ 120Hz RB
+	{0x0E, 60, false, 33.75, 848, 16, 112, 112, 480, 6, 8, 23, true, true, false},      // 848x480p @ 60Hz
+	{0x0F, 43, false, 44.9, 1024, 8, 176, 56, 384, 0, 4, 20, true, true, true},         // 1024x768i @ 43Hz
+	{0x10, 60, false, 65, 1024, 24, 136, 160, 768, 3, 6, 29, false, false, false},      // 1024x768p @ 60Hz
+	{0x11, 70, false, 75, 1024, 24, 136, 144, 768, 3, 6, 29, false, false, false},      // 1024x768p @ 70Hz
+	{0x12, 75, false, 78.75, 1024, 16, 96, 176, 768, 1, 3, 28, true, true, false},      // 1024x768p @ 75Hz
+	{0x13, 85, false, 94.5, 1024, 48, 96, 208, 768, 1, 3, 36, true, true, false},       // 1024x768p @ 85Hz
+	{0x14, 120, true, 115.5, 1024, 48, 32, 80, 768, 3, 4, 38, true, false, false},      // 1024x768p @ 120Hz RB
+	{0x15, 75, false, 108, 1152, 64, 128, 256, 864, 1, 3, 32, true, true, false},       // 1152x864p @ 75Hz
+	{0x16, 60, true, 68.25, 1280, 48, 32, 80, 768, 3, 7, 12, true, false, false},       // 1280x768p @ 60Hz RB
+	{0x17, 60, false, 79.5, 1280, 64, 128, 192, 768, 3, 7, 20, false, true, false},     // 1280x768p @ 60Hz
+	{0x18, 75, false, 102.25, 1280, 80, 128, 208, 768, 3, 7, 27, false, true, false},   // 1280x768p @ 75Hz
+	{0x19, 85, false, 117.5, 1280, 80, 136, 216, 768, 3, 7, 31, false, true, false},    // 1280x768p @ 85Hz
+	{0x1A, 120, true, 140.25, 1280, 48, 32, 80, 768, 3, 7, 35, true, false, false},     // 1280x768p @ 120Hz RB
+	{0x1B, 60, true, 71, 1280, 48, 32, 80, 800, 3, 6, 14, true, false, false},          // 1280x800p @ 60Hz RB
+	{0x1C, 60, false, 83.5, 1280, 72, 128, 200, 800, 3, 6, 22, false, true, false},     // 1280x800p @ 60Hz
+	{0x1D, 75, false, 106.5, 1280, 80, 128, 208, 800, 3, 6, 29, false, true, false},    // 1280x800p @ 75Hz
+	{0x1E, 85, false, 122.5, 1280, 80, 136, 216, 800, 3, 6, 34, false, true, false},    // 1280x800p @ 85Hz
+	{0x1F, 120, true, 146.25, 1280, 48, 32, 80, 800, 3, 6, 38, true, false, false},     // 1280x800p @ 120Hz RB
+	{0x20, 60, false, 108, 1280, 96, 112, 312, 960, 1, 3, 36, true, true, false},       // 1280x960p @ 60Hz
+	{0x21, 85, false, 148.5, 1280, 64, 160, 224, 960, 1, 3, 47, true, true, false},     // 1280x960p @ 85Hz
+	{0x22, 120, true, 175.5, 1280, 48, 32, 80, 960, 3, 4, 50, true, false, false},      // 1280x960p @ 120Hz RB
+	{0x23, 60, false, 108, 1280, 48, 112, 248, 1024, 1, 3, 38, true, true, false},      // 1280x1024p @ 60Hz
+	{0x24, 75, false, 135, 1280, 16, 144, 248, 1024, 1, 3, 38, true, true, false},      // 1280x1024p @ 75Hz
+	{0x25, 85, false, 157.5, 1280, 64, 160, 224, 1024, 1, 3, 44, true, true, false},    // 1280x1024p @ 85Hz
+	{0x26, 120, true, 187.25, 1280, 48, 32, 80, 1024, 3, 7, 50, true, false, false},    // 1280x1024p @ 120Hz RB
+	{0x27, 60, false, 85.5, 1360, 64, 112, 256, 768, 3, 6, 18, true, true, false},      // 1360x768p @ 60Hz
+	{0x28, 120, true, 148.25, 1360, 48, 32, 80, 768, 3, 5, 37, true, false, false},     // 1360x768p @ 120Hz RB
+	{0x29, 60, true, 101, 1400, 48, 32, 80, 1050, 3, 4, 23, true, false, false},        // 1400x1050p @ 60Hz RB
+	{0x2A, 60, false, 121.75, 1400, 88, 144, 232, 1050, 3, 4, 32, false, true, false},  // 1400x1050p @ 60Hz
+	{0x2B, 75, false, 156, 1400, 104, 144, 248, 1050, 3, 4, 42, false, true, false},    // 1400x1050p @ 75Hz
+	{0x2C, 85, false, 179.5, 1400, 104, 152, 256, 1050, 3, 4, 48, false, true, false},  // 1400x1050p @ 85Hz
+	{0x2D, 120, true, 208, 1400, 48, 32, 80, 1050, 3, 4, 55, true, false, false},       // 1400x1050p @ 120Hz RB
+	{0x2E, 60, true, 88.75, 1440, 48, 32, 80, 900, 3, 6, 17, true, false, false},       // 1440x900p @ 60Hz RB
+	{0x2F, 60, false, 106.5, 1440, 80, 152, 232, 900, 3, 6, 25, false, true, false},    // 1440x900p @ 60Hz
+	{0x30, 75, false, 136.75, 1440, 96, 152, 248, 900, 3, 6, 33, false, true, false},   // 1440x900p @ 75Hz
+	{0x31, 85, false, 157, 1440, 104, 152, 256, 900, 3, 6, 39, false, true, false},     // 1440x900p @ 85Hz
+	{0x32, 120, true, 182.75, 1440, 48, 32, 80, 900, 3, 6, 44, true, false, false},     // 1440x900p @ 120Hz RB
+	{0x33, 60, false, 162, 1600, 64, 192, 304, 1200, 1, 3, 46, true, true, false},      // 1600x1200p @ 60Hz
+	{0x34, 65, false, 175.5, 1600, 64, 192, 304, 1200, 1, 3, 46, true, true, false},    // 1600x1200p @ 65Hz
+	{0x35, 70, false, 189, 1600, 64, 192, 304, 1200, 1, 3, 46, true, true, false},      // 1600x1200p @ 70Hz
+	{0x36, 75, false, 202.5, 1600, 64, 192, 304, 1200, 1, 3, 46, true, true, false},    // 1600x1200p @ 75Hz
+	{0x37, 85, false, 229.5, 1600, 64, 192, 304, 1200, 1, 3, 46, true, true, false},    // 1600x1200p @ 85Hz
+	{0x38, 120, true, 268.25, 1600, 48, 32, 80, 1200, 3, 4, 64, true, false, false},    // 1600x1200p @ 120Hz RB
+	{0x39, 60, true, 119, 1680, 48, 32, 80, 1050, 3, 6, 21, true, false, false},        // 1680x1050p @ 60Hz RB
+	{0x3A, 60, false, 146.25, 1680, 104, 176, 280, 1050, 3, 6, 30, false, true, false}, // 1680x1050p @ 60Hz
+	{0x3B, 75, false, 187, 1680, 120, 176, 296, 1050, 3, 6, 40, false, true, false},    // 1680x1050p @ 75Hz
+	{0x3C, 85, false, 214.75, 1680, 128, 176, 304, 1050, 3, 6, 46, false, true, false}, // 1680x1050p @ 85Hz
+	{0x3D, 120, true, 245.5, 1680, 48, 32, 80, 1050, 3, 6, 53, true, false, false},     // 1680x1050p @ 120Hz RB
+	{0x3E, 60, false, 204.75, 1792, 128, 200, 328, 1344, 1, 3, 46, false, true, false}, // 1792x1344p @ 60Hz
+	{0x3F, 75, false, 261, 1792, 96, 216, 352, 1344, 1, 3, 69, false, true, false},     // 1792x1344p @ 75Hz
+	{0x40, 120, true, 333.25, 1792, 48, 32, 80, 1344, 3, 4, 72, true, false, false},    // 1792x1344p @ 120Hz RB
+	{0x41, 60, false, 218.25, 1856, 96, 224, 352, 1392, 1, 3, 43, false, true, false},  // 1856x1392p @ 60Hz
+	{0x42, 75, false, 288, 1856, 128, 224, 352, 1392, 1, 3, 104, false, true, false},   // 1856x1392p @ 75Hz
+	{0x43, 120, true, 356.5, 1856, 48, 32, 80, 1392, 3, 4, 75, true, false, false},     // 1856x1392p @ 120Hz RB
+	{0x44, 60, true, 154, 1920, 48, 32, 80, 1200, 3, 6, 26, true, false, false},        // 1920x1200p @ 60Hz RB
+	{0x45, 60, false, 193.25, 1920, 136, 200, 336, 1200, 3, 6, 36, false, true, false}, // 1920x1200p @ 60Hz
+	{0x46, 75, false, 245.25, 1920, 136, 208, 344, 1200, 3, 6, 46, false, true, false}, // 1920x1200p @ 75Hz
+	{0x47, 85, false, 281.25, 1920, 144, 208, 352, 1200, 3, 6, 53, false, true, false}, // 1920x1200p @ 85Hz
+	{0x48, 120, true, 317, 1920, 48, 32, 80, 1200, 3, 6, 62, true, false, false},       // 1920x1200p @ 120Hz RB
+	{0x49, 60, false, 234, 1920, 128, 208, 344, 1440, 1, 3, 56, false, true, false},    // 1920x1440p @ 60Hz
+	{0x4A, 75, false, 297, 1920, 144, 224, 352, 1440, 1, 3, 56, false, true, false},    // 1920x1440p @ 75Hz
+	{0x4B, 120, true, 380.5, 1920, 48, 32, 80, 1440, 3, 4, 78, true, false, false},     // 1920x1440p @ 120Hz RB
+	{0x4C, 60, true, 268.5, 2560, 48, 32, 80, 1600, 3, 6, 37, true, false, false},      // 2560x1600p @ 60Hz RB
+	{0x4D, 60, false, 348.5, 2560, 192, 280, 472, 1600, 3, 6, 49, false, true, false},  // 2560x1600p @ 60Hz
+	{0x4E, 75, false, 443.25, 2560, 208, 280, 488, 1600, 3, 6, 63, false, true, false}, // 2560x1600p @ 75Hz
+	{0x4F, 85, false, 505.25, 2560, 208, 280, 488, 1600, 3, 6, 73, false, true, false}, // 2560x1600p @ 85Hz
+	{0x50, 120, true, 552.75, 2560, 48, 32, 80, 1600, 3, 6, 85, true, false, false},    // 2560x1600p @ 120Hz RB
+	{0x51, 60, false, 85.5, 1366, 70, 143, 213, 768, 3, 3, 24, true, true, false},      // 1366x768p @ 60Hz
+	{0x52, 60, false, 148.5, 1920, 88, 44, 148, 1080, 4, 5, 36, true, true, false},     // 1920x1080p @ 60Hz
+	{0x53, 60, true, 108, 1600, 24, 80, 96, 900, 1, 3, 96, true, true, false},          // 1600x900p @ 60Hz RB
+	{0x54, 60, true, 162, 2048, 26, 80, 96, 1152, 1, 3, 44, true, true, false},         // 2048x1152p @ 60Hz RB
+	{0x55, 60, false, 74.25, 1280, 110, 40, 220, 720, 5, 5, 20, true, true, false},     // 1280x720p @ 60Hz
+	{0x56, 60, true, 72, 1366, 14, 56, 64, 768, 1, 3, 28, true, true, false},           // 1366x768p @ 60Hz RB
+	{0x57, 60, true, 556.744, 4096, 8, 32, 40, 2160, 48, 8, 6, true, false, false},     // 4096x2160p @ 60Hz RB
+	{0x58, 60, true, 556.188, 4096, 8, 32, 40, 2160, 48, 8, 6, true, false, false},     // 4096x2160p @ 59.94Hz RB
+}
+
+func (dmt dmtTiming) timing() Timing {
+	t := Timing{
+		PixelClock:             dmt.pixelClock,
+		HorizontalActive:       dmt.hActive,
+		HorizontalBlanking:     dmt.hFrontPorch + dmt.hSyncWidth + dmt.hBackPorch,
+		HorizontalFrontPorch:   dmt.hFrontPorch,
+		HorizontalSyncWidth:    dmt.hSyncWidth,
+		HorizontalSyncPositive: dmt.hSyncPositive,
+		VerticalActive:         dmt.vActive,
+		VerticalBlanking:       dmt.vFrontPorch + dmt.vSyncWidth + dmt.vBackPorch,
+		VerticalFrontPorch:     dmt.vFrontPorch,
+		VerticalSyncWidth:      dmt.vSyncWidth,
+		VerticalSyncPositive:   dmt.vSyncPositive,
+		Interlaced:             dmt.interlaced,
+	}
+	t.RefreshRate = t.refreshRate()
+	return t
+}
+
+func dmtByID(id int) (dmtTiming, bool) {
+	for _, dmt := range dmtTable {
+		if dmt.id == id {
+			return dmt, true
+		}
+	}
+	return dmtTiming{}, false
+}
+
+// dmtByMode finds the progressive DMT for a resolution and refresh rate,
+// preferring the timing without reduced blanking when both exist
+func dmtByMode(hActive, vActive, refreshRate int, reducedBlanking bool) (dmtTiming, bool) {
+	var found dmtTiming
+	ok := false
+	for _, dmt := range dmtTable {
+		if dmt.interlaced || dmt.hActive != hActive || dmt.vActive != vActive || dmt.refreshRate != refreshRate {
+			continue
+		}
+		if dmt.reducedBlanking == reducedBlanking {
+			return dmt, true
+		}
+		if !ok {
+			found, ok = dmt, true
+		}
+	}
+	return found, ok
+}
@@ -6,12 +6,23 @@ import (
 
 func ReadEDID(data []byte) (EDID, error) {
 	var edid EDID
-	if len(data) != EDID_SIZE && len(data) != EXTENDED_EDID_SIZE {
+	if len(data) < EDID_SIZE || len(data)%EXTENSION_SIZE != 0 {
 		return edid, fmt.Errorf("Invalid EDID size: %d", len(data))
 	}
-	copy(edid.rawData[:], data)
+	extensionCount := int(data[EXTENSION_FLAG_OFFSET])
+	if len(data) != EDID_SIZE+extensionCount*EXTENSION_SIZE {
+		return edid, fmt.Errorf("Invalid EDID size: %d, extension flag announces %d extension blocks", len(data), extensionCount)
+	}
+	edid.rawData = make([]byte, len(data))
+	copy(edid.rawData, data)
 	copy(edid.edidData[:], data[:EDID_SIZE])
-	copy(edid.ctaData[:], data[EDID_SIZE:EDID_SIZE+CTA_SIZE])
+	for i := 0; i < extensionCount; i++ {
+		var ext ExtensionBlock
+		start := EDID_SIZE + i*EXTENSION_SIZE
+		copy(ext.data[:], data[start:start+EXTENSION_SIZE])
+		ext.tag = ext.data[0]
+		edid.extensions = append(edid.extensions, ext)
+	}
 
 	offset := 0
 	copy(edid.fixedHeader[:], data[offset:offset+FIXED_HEADER_SIZE])
@@ -53,3 +64,29 @@ func ReadEDID(data []byte) (EDID, error) {
 
 	return edid, nil
 }
+
+func ReadDisplayID(data []byte) (DisplayIDStructure, error) {
+	var displayID DisplayIDStructure
+	offset := 0
+	for offset < len(data) {
+		// Trailing zero bytes pad the structure to its storage size
+		if offset > 0 && isPadding(data[offset:]) {
+			break
+		}
+		if offset+DISPLAYID_SECTION_HEADER_SIZE+CHECKSUM_SIZE > len(data) {
+			return displayID, fmt.Errorf("Invalid DisplayID size: %d, section at offset %d is truncated", len(data), offset)
+		}
+		size := DISPLAYID_SECTION_HEADER_SIZE + int(data[offset+1]) + CHECKSUM_SIZE
+		if offset+size > len(data) {
+			return displayID, fmt.Errorf("Invalid DisplayID size: %d, section at offset %d announces %d bytes", len(data), offset, size)
+		}
+		section := make([]byte, size)
+		copy(section, data[offset:offset+size])
+		displayID.sections = append(displayID.sections, section)
+		offset += size
+	}
+	if len(displayID.sections) == 0 {
+		return displayID, fmt.Errorf("Invalid DisplayID size: %d", len(data))
+	}
+	return displayID, nil
+}
@@ -0,0 +1,155 @@
+package edid
+
+import (
+	"bytes"
+	"testing"
+)
+
+// testCTAExtension returns a CTA-861 extension block without data blocks
+// and detailed timings
+func testCTAExtension() []byte {
+	data := make([]byte, EXTENSION_SIZE-CHECKSUM_SIZE)
+	data[0] = EXTENSION_TAG_CTA
+	data[1] = 0x03
+	data[2] = 0x04
+	return append(data, generateChecksum(data))
+}
+
+func TestReadEDIDExtensions(t *testing.T) {
+	displayID, err := generateDisplayIDExtension(make([]byte, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for count := 0; count <= 3; count++ {
+		data := testBaseBlock(byte(count))
+		tags := make([]byte, 0, count)
+		for i := 0; i < count; i++ {
+			if i%2 == 0 {
+				data = append(data, testCTAExtension()...)
+				tags = append(tags, EXTENSION_TAG_CTA)
+			} else {
+				data = append(data, displayID.data[:]...)
+				tags = append(tags, EXTENSION_TAG_DISPLAYID)
+			}
+		}
+
+		edid, err := ReadEDID(data)
+		if err != nil {
+			t.Fatalf("%d extensions: %v", count, err)
+		}
+		if len(edid.extensions) != count {
+			t.Fatalf("%d extensions: got %d extension blocks", count, len(edid.extensions))
+		}
+		for i, ext := range edid.extensions {
+			if ext.tag != tags[i] {
+				t.Errorf("%d extensions: extension %d tag 0x%02x, want 0x%02x", count, i, ext.tag, tags[i])
+			}
+		}
+		if generated := GenerateEDID(&edid); !bytes.Equal(generated, data) {
+			t.Errorf("%d extensions: generated EDID differs from the input", count)
+		}
+
+		decoded, err := edid.Decode()
+		if err != nil {
+			t.Fatalf("%d extensions: %v", count, err)
+		}
+		if len(decoded.Extensions) != count {
+			t.Fatalf("%d extensions: decoded %d extension blocks", count, len(decoded.Extensions))
+		}
+		for i, ext := range decoded.Extensions {
+			if ext.Index != i+1 || !ext.ChecksumValid {
+				t.Errorf("%d extensions: extension %d decoded as index %d, checksum valid %v", count, i, ext.Index, ext.ChecksumValid)
+			}
+		}
+	}
+}
+
+func TestReadEDIDInvalidSize(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated base block", testBaseBlock(0)[:EDID_SIZE-1]},
+		{"partial extension block", append(testBaseBlock(1), make([]byte, EXTENSION_SIZE/2)...)},
+		{"missing extension block", append(testBaseBlock(2), testCTAExtension()...)},
+		{"unannounced extension block", append(testBaseBlock(0), testCTAExtension()...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadEDID(tt.data); err == nil {
+				t.Error("expected an invalid size error")
+			}
+		})
+	}
+}
+
+// testDisplayIDSection returns a checksummed DisplayID section holding the
+// given data blocks
+func testDisplayIDSection(revision byte, productType byte, extensionCount byte, blocks ...[]byte) []byte {
+	section := []byte{revision, 0x00, productType, extensionCount}
+	for _, block := range blocks {
+		section = append(section, block...)
+	}
+	section[1] = byte(len(section) - DISPLAYID_SECTION_HEADER_SIZE)
+	return append(section, generateChecksum(section))
+}
+
+func TestReadDisplayID(t *testing.T) {
+	containerID := append([]byte{0x29, 0x00, 16}, bytes.Repeat([]byte{0xA5}, 16)...)
+	data := testDisplayIDSection(DISPLAYID_VERSION_2_0, 0x03, 1, testTiledTopologyBlock)
+	data = append(data, testDisplayIDSection(DISPLAYID_VERSION_2_0, 0x00, 0, containerID)...)
+	// Pad the structure to its storage size
+	padded := append(append([]byte{}, data...), make([]byte, 256-len(data))...)
+
+	displayID, err := ReadDisplayID(padded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if generated := GenerateDisplayID(&displayID); !bytes.Equal(generated, data) {
+		t.Errorf("generated DisplayID differs from the input\ngot  % x\nwant % x", generated, data)
+	}
+
+	decoded, err := displayID.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Warnings) != 0 {
+		t.Errorf("unexpected warnings %v", decoded.Warnings)
+	}
+	if len(decoded.Sections) != 2 {
+		t.Fatalf("got %d sections, want 2", len(decoded.Sections))
+	}
+	first := decoded.Sections[0]
+	if first.Revision != DISPLAYID_VERSION_2_0 || first.ExtensionCount != 1 || !first.ChecksumValid {
+		t.Errorf("first section: revision 0x%02x, %d extensions, checksum valid %v", first.Revision, first.ExtensionCount, first.ChecksumValid)
+	}
+	if len(first.Blocks) != 1 || first.Blocks[0].TiledTopology == nil {
+		t.Fatal("first section: tiled display topology missing")
+	}
+	if tile := first.Blocks[0].TiledTopology; tile.HorizontalTiles != 40 || tile.VerticalTiles != 20 {
+		t.Errorf("first section: got %d x %d tiles, want 40 x 20", tile.HorizontalTiles, tile.VerticalTiles)
+	}
+	second := decoded.Sections[1]
+	if len(second.Blocks) != 1 || second.Blocks[0].ContainerID == "" || !second.ChecksumValid {
+		t.Errorf("second section: got %+v, want a valid ContainerID block", second)
+	}
+}
+
+func TestReadDisplayIDInvalidSize(t *testing.T) {
+	section := testDisplayIDSection(DISPLAYID_VERSION_2_0, 0x00, 0, []byte{0x29, 0x00, 16})
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", []byte{}},
+		{"truncated header", section[:3]},
+		{"truncated section", section[:len(section)-1]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadDisplayID(tt.data); err == nil {
+				t.Error("expected an invalid size error")
+			}
+		})
+	}
+}
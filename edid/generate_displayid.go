@@ -0,0 +1,10 @@
+package edid
+
+func GenerateDisplayID(reference *DisplayIDStructure) []byte {
+	var data []byte
+	for _, section := range reference.sections {
+		data = append(data, section[:len(section)-CHECKSUM_SIZE]...)
+		data = append(data, generateChecksum(section[:len(section)-CHECKSUM_SIZE]))
+	}
+	return data
+}
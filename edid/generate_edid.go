@@ -1,7 +1,9 @@
 package edid
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math"
 )
 
 func generateChecksum(data []byte) byte {
@@ -65,7 +67,50 @@ func GenerateEDID(reference *EDID) []byte {
 	for i := 0; i < DISPLAY_DESCRIPTOR_COUNT; i++ {
 		data = append(data, reference.displayDescriptor[i][:]...)
 	}
-	data = append(data, reference.extensionFlag)
+	data = append(data, byte(len(reference.extensions)))
 	data = append(data, generateChecksum(data))
+	for _, ext := range reference.extensions {
+		data = append(data, ext.data[:EXTENSION_SIZE-CHECKSUM_SIZE]...)
+		data = append(data, generateChecksum(ext.data[:EXTENSION_SIZE-CHECKSUM_SIZE]))
+	}
 	return data
 }
+
+// generateDetailedTimingDescriptor encodes a progressive digital separate
+// sync timing, the inverse of parseDisplayTimingDescriptor
+func generateDetailedTimingDescriptor(dtd DetailedTimingDescriptor) ([DISPLAY_DESCRIPTOR_SIZE]byte, error) {
+	var dd [DISPLAY_DESCRIPTOR_SIZE]byte
+	pixelClock := math.Round(dtd.PixelClock * 100)
+	if pixelClock < 1 || pixelClock > 0xFFFF {
+		return dd, fmt.Errorf("Invalid detailed timing pixel clock: %.2f MHz", dtd.PixelClock)
+	}
+	if dtd.HorizontalActive > 0xFFF || dtd.HorizontalBlanking > 0xFFF || dtd.VerticalActive > 0xFFF || dtd.VerticalBlanking > 0xFFF ||
+		dtd.HorizontalFrontPorch > 0x3FF || dtd.HorizontalSyncWidth > 0x3FF || dtd.VerticalFrontPorch > 0x3F || dtd.VerticalSyncWidth > 0x3F {
+		return dd, fmt.Errorf("Invalid detailed timing: %s does not fit in a detailed timing descriptor", timingString(dtd.Timing))
+	}
+	binary.LittleEndian.PutUint16(dd[0:2], uint16(pixelClock))
+	dd[2] = byte(dtd.HorizontalActive)
+	dd[3] = byte(dtd.HorizontalBlanking)
+	dd[4] = byte(dtd.HorizontalActive>>8)<<4 | byte(dtd.HorizontalBlanking>>8)
+	dd[5] = byte(dtd.VerticalActive)
+	dd[6] = byte(dtd.VerticalBlanking)
+	dd[7] = byte(dtd.VerticalActive>>8)<<4 | byte(dtd.VerticalBlanking>>8)
+	dd[8] = byte(dtd.HorizontalFrontPorch)
+	dd[9] = byte(dtd.HorizontalSyncWidth)
+	dd[10] = byte(dtd.VerticalFrontPorch&0x0F)<<4 | byte(dtd.VerticalSyncWidth&0x0F)
+	dd[11] = byte(dtd.HorizontalFrontPorch>>8)<<6 | byte(dtd.HorizontalSyncWidth>>8)<<4 |
+		byte(dtd.VerticalFrontPorch>>4)<<2 | byte(dtd.VerticalSyncWidth>>4)
+	dd[12] = byte(dtd.ImageWidth)
+	dd[13] = byte(dtd.ImageHeight)
+	dd[14] = byte(dtd.ImageWidth>>8)<<4 | byte(dtd.ImageHeight>>8)&0x0F
+	dd[15] = byte(dtd.HorizontalBorder)
+	dd[16] = byte(dtd.VerticalBorder)
+	dd[17] = FD_DIGITAL_ANALOG_SYNC | FD_DIGITAL_COMPOSITE_SYNC
+	if dtd.VerticalSyncPositive {
+		dd[17] |= FD_DIGITAL_VSYNC_POLARITY
+	}
+	if dtd.HorizontalSyncPositive {
+		dd[17] |= FD_DIGITAL_HSYNC_POLARITY
+	}
+	return dd, nil
+}
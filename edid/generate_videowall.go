@@ -0,0 +1,184 @@
+package edid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// encodeBezel converts a bezel size in pixels to tenths of the pixel multiplier
+func encodeBezel(size float64, pixelMultiplier int) (byte, error) {
+	if size == 0 {
+		return 0, nil
+	}
+	if pixelMultiplier == 0 {
+		return 0, fmt.Errorf("Invalid pixel multiplier: bezel sizes require a pixel multiplier")
+	}
+	value := math.Round(size * 10.0 / float64(pixelMultiplier))
+	if value < 0 || value > 0xFF {
+		return 0, fmt.Errorf("Invalid bezel size: %.1f pixels with pixel multiplier %d", size, pixelMultiplier)
+	}
+	return byte(value), nil
+}
+
+// generateTiledDisplayTopology encodes a DisplayID 2.x tiled display topology
+// block (0x28), the inverse of parseTiledDisplayTopology
+func generateTiledDisplayTopology(topology TiledDisplayTopology) ([]byte, error) {
+	block := make([]byte, CTA_BLOCK_TILED_SIZE)
+	block[0] = CTA_BLOCK_TILED_DISPLAY
+	block[1] = topology.Revision
+	block[2] = CTA_BLOCK_TILED_SIZE - DISPLAYID_BLOCK_HEADER_SIZE
+
+	block[3] = topology.OneTileBehavior&TILE_ONE_TILE_BEHAVIOR | topology.NTileBehavior<<3&TILE_N_TILE_BEHAVIOR
+	if topology.SingleEnclosure {
+		block[3] |= TILE_PHYSICAL_ENCLOSURE
+	}
+
+	// Tile counts are stored minus one, all fields are 6 bits split in a
+	// 4 bit LSB and a 2 bit MSB part
+	hTiles := topology.HorizontalTiles - 1
+	vTiles := topology.VerticalTiles - 1
+	hLocation := topology.HorizontalLocation
+	vLocation := topology.VerticalLocation
+	block[4] = byte(hTiles&0x0F)<<4 | byte(vTiles&0x0F)
+	block[5] = byte(hLocation&0x0F)<<4 | byte(vLocation&0x0F)
+	block[6] = byte(hTiles>>4)<<6&TILE_H_TILES_MSB | byte(vTiles>>4)<<4&TILE_V_TILES_MSB |
+		byte(hLocation>>4)<<2&TILE_H_LOCATION_MSB | byte(vLocation>>4)&TILE_V_LOCATION_MSB
+
+	binary.LittleEndian.PutUint16(block[7:9], uint16(topology.TileWidth-1))
+	binary.LittleEndian.PutUint16(block[9:11], uint16(topology.TileHeight-1))
+
+	bezels := []float64{topology.TopBezel, topology.BottomBezel, topology.RightBezel, topology.LeftBezel}
+	for i, size := range bezels {
+		value, err := encodeBezel(size, topology.PixelMultiplier)
+		if err != nil {
+			return nil, err
+		}
+		block[12+i] = value
+		if value != 0 {
+			block[3] |= TILE_BEZEL_DESCRIPTOR
+		}
+	}
+	if block[3]&TILE_BEZEL_DESCRIPTOR != 0 {
+		block[11] = byte(topology.PixelMultiplier)
+	}
+
+	var oui [3]byte
+	if _, err := fmt.Sscanf(topology.VendorID, "%02X-%02X-%02X", &oui[0], &oui[1], &oui[2]); err != nil {
+		return nil, fmt.Errorf("Invalid topology vendor ID: %q is not an OUI", topology.VendorID)
+	}
+	copy(block[16:19], oui[:])
+	binary.LittleEndian.PutUint16(block[19:21], topology.ProductCode)
+	binary.LittleEndian.PutUint32(block[21:25], topology.SerialNumber)
+	return block, nil
+}
+
+// generateDisplayIDExtension wraps DisplayID 2.0 data blocks in a single
+// section inside a checksummed extension block
+func generateDisplayIDExtension(blocks ...[]byte) (ExtensionBlock, error) {
+	var ext ExtensionBlock
+	var payload []byte
+	for _, block := range blocks {
+		payload = append(payload, block...)
+	}
+	maxPayload := EXTENSION_SIZE - CTA_EXT_TAG_SIZE - DISPLAYID_SECTION_HEADER_SIZE - 2*CHECKSUM_SIZE
+	if len(payload) > maxPayload {
+		return ext, fmt.Errorf("Invalid DisplayID payload size: %d, an extension block holds at most %d bytes", len(payload), maxPayload)
+	}
+
+	ext.tag = EXTENSION_TAG_DISPLAYID
+	ext.data[0] = EXTENSION_TAG_DISPLAYID
+	section := []byte{DISPLAYID_VERSION_2_0, byte(len(payload)), 0x00, 0x00}
+	section = append(section, payload...)
+	section = append(section, generateChecksum(section))
+	copy(ext.data[CTA_EXT_TAG_SIZE:], section)
+	ext.data[EXTENSION_SIZE-CHECKSUM_SIZE] = generateChecksum(ext.data[:EXTENSION_SIZE-CHECKSUM_SIZE])
+	return ext, nil
+}
+
+func hasTiledDisplayTopology(edid *EDID) (int, bool) {
+	var warnings []string
+	for i := range edid.extensions {
+		if edid.extensions[i].tag != EXTENSION_TAG_DISPLAYID {
+			continue
+		}
+		displayID, _ := decodeDisplayIDExtension(&edid.extensions[i], &warnings)
+		for _, section := range displayID.Sections {
+			for _, block := range section.Blocks {
+				if block.Tag == CTA_BLOCK_TILED_DISPLAY || block.Tag == CTA_BLOCK_TILED_DISPLAY_LEGACY {
+					return i, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// tilePreferredTiming returns the base EDID preferred detailed timing
+// descriptor resized to the tile resolution. A matching preferred timing is
+// kept as is, otherwise a CVT reduced blanking timing at the refresh rate of
+// the preferred timing replaces it.
+func tilePreferredTiming(base *EDID, topology TiledDisplayTopology) ([DISPLAY_DESCRIPTOR_SIZE]byte, error) {
+	preferred := base.displayDescriptor[0]
+	if preferred[0] == 0x00 && preferred[1] == 0x00 {
+		return preferred, fmt.Errorf("Invalid base EDID: display descriptor 0 is not a preferred detailed timing")
+	}
+	dtd := parseDisplayTimingDescriptor(preferred)
+	if dtd.HorizontalActive == topology.TileWidth && dtd.VerticalActive == topology.TileHeight && !dtd.Interlaced {
+		return preferred, nil
+	}
+	dtd.Timing = cvtTiming(topology.TileWidth, topology.TileHeight, math.Round(dtd.RefreshRate), CVT_REDUCED_BLANKING, false)
+	dtd.HorizontalBorder = 0
+	dtd.VerticalBorder = 0
+	return generateDetailedTimingDescriptor(dtd)
+}
+
+// GenerateVideoWall generates one EDID per tile of a video wall. Every EDID
+// is a copy of the base EDID with an additional DisplayID extension holding
+// the tiled display topology, only the tile location differs between them.
+// The EDIDs are returned row by row, the tile locations in the topology are
+// ignored. The preferred detailed timing of every EDID is set to the tile
+// resolution.
+func GenerateVideoWall(base *EDID, topology TiledDisplayTopology) ([][]byte, error) {
+	if topology.HorizontalTiles < 1 || topology.HorizontalTiles > TILE_MAX_TILES ||
+		topology.VerticalTiles < 1 || topology.VerticalTiles > TILE_MAX_TILES {
+		return nil, fmt.Errorf("Invalid video wall size: %d x %d, each side holds 1 to %d tiles", topology.HorizontalTiles, topology.VerticalTiles, TILE_MAX_TILES)
+	}
+	if topology.TileWidth < 1 || topology.TileWidth > 0x10000 || topology.TileHeight < 1 || topology.TileHeight > 0x10000 {
+		return nil, fmt.Errorf("Invalid tile size: %d x %d", topology.TileWidth, topology.TileHeight)
+	}
+	if len(base.extensions) >= 0xFF {
+		return nil, fmt.Errorf("Invalid base EDID: no room for another extension block")
+	}
+	if i, found := hasTiledDisplayTopology(base); found {
+		return nil, fmt.Errorf("Invalid base EDID: extension block %d already holds a tiled display topology", i+1)
+	}
+
+	preferred, err := tilePreferredTiming(base, topology)
+	if err != nil {
+		return nil, err
+	}
+
+	var edids [][]byte
+	for v := 0; v < topology.VerticalTiles; v++ {
+		for h := 0; h < topology.HorizontalTiles; h++ {
+			tile := topology
+			tile.HorizontalLocation = h
+			tile.VerticalLocation = v
+			block, err := generateTiledDisplayTopology(tile)
+			if err != nil {
+				return nil, err
+			}
+			ext, err := generateDisplayIDExtension(block)
+			if err != nil {
+				return nil, err
+			}
+
+			wall := *base
+			wall.displayDescriptor[0] = preferred
+			wall.extensions = append(append([]ExtensionBlock{}, base.extensions...), ext)
+			edids = append(edids, GenerateEDID(&wall))
+		}
+	}
+	return edids, nil
+}
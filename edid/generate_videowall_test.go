@@ -0,0 +1,143 @@
+package edid
+
+import (
+	"math"
+	"testing"
+)
+
+// testBaseBlock returns a valid EDID 1.4 base block with a 1920x1080@60
+// preferred timing, a range limits, a monitor name and a serial number
+// descriptor
+func testBaseBlock(extensionCount byte) []byte {
+	data := []byte{
+		0x00, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00,
+		0x10, 0xAC, 0xB1, 0xA0, 0x39, 0x30, 0x00, 0x00, 10, 30, 1, 4,
+		0xA5, 53, 30, 0x78, 0x2A,
+		0xEE, 0x91, 0xA3, 0x54, 0x4C, 0x99, 0x26, 0x0F, 0x50, 0x54,
+		0x21, 0x08, 0x00,
+		0xD1, 0xC0, 0x81, 0x80, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+		0x02, 0x3A, 0x80, 0x18, 0x71, 0x38, 0x2D, 0x40, 0x58, 0x2C, 0x45, 0x00, 0x0F, 0x28, 0x21, 0x00, 0x00, 0x1E,
+		0x00, 0x00, 0x00, 0xFD, 0x00, 0x38, 0x4C, 0x1E, 0x53, 0x11, 0x00, 0x0A, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+		0x00, 0x00, 0x00, 0xFC, 0x00, 'T', 'E', 'S', 'T', ' ', 'M', 'O', 'N', 'I', 'T', 'O', 'R', '\n',
+		0x00, 0x00, 0x00, 0xFF, 0x00, 'S', 'N', '1', '2', '3', '4', '5', '6', '\n', ' ', ' ', ' ', ' ',
+		extensionCount,
+	}
+	return append(data, generateChecksum(data))
+}
+
+func TestGenerateVideoWall(t *testing.T) {
+	base, err := ReadEDID(testBaseBlock(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	topology := TiledDisplayTopology{
+		OneTileBehavior: 0x01,
+		NTileBehavior:   0x01,
+		HorizontalTiles: 20,
+		VerticalTiles:   2,
+		TileWidth:       1280,
+		TileHeight:      720,
+		PixelMultiplier: 2,
+		TopBezel:        10,
+		BottomBezel:     10,
+		RightBezel:      20.4,
+		LeftBezel:       20.4,
+		VendorID:        "00-0C-03",
+		ProductCode:     0x1234,
+		SerialNumber:    0x12345678,
+	}
+
+	edids, err := GenerateVideoWall(&base, topology)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(edids) != 40 {
+		t.Fatalf("got %d EDIDs, want 40", len(edids))
+	}
+	for i, data := range edids {
+		wall, err := ReadEDID(data)
+		if err != nil {
+			t.Fatalf("EDID %d: %v", i, err)
+		}
+		decoded, err := wall.Decode()
+		if err != nil {
+			t.Fatalf("EDID %d: %v", i, err)
+		}
+		if !decoded.ChecksumValid {
+			t.Errorf("EDID %d: invalid base block checksum", i)
+		}
+		if len(decoded.Warnings) != 0 {
+			t.Errorf("EDID %d: unexpected warnings %v", i, decoded.Warnings)
+		}
+
+		dtd := decoded.DisplayDescriptors[0].DetailedTiming
+		if dtd == nil {
+			t.Fatalf("EDID %d: preferred detailed timing missing", i)
+		}
+		if dtd.HorizontalActive != 1280 || dtd.VerticalActive != 720 || math.Abs(dtd.RefreshRate-60) > 0.5 {
+			t.Errorf("EDID %d: preferred timing %s, want 1280x720p @ 60Hz", i, timingString(dtd.Timing))
+		}
+		if dtd.ImageWidth != 527 || dtd.ImageHeight != 296 {
+			t.Errorf("EDID %d: image size %dx%d, want 527x296", i, dtd.ImageWidth, dtd.ImageHeight)
+		}
+
+		if len(decoded.Extensions) != 1 || decoded.Extensions[0].DisplayID == nil {
+			t.Fatalf("EDID %d: DisplayID extension missing", i)
+		}
+		if !decoded.Extensions[0].ChecksumValid {
+			t.Errorf("EDID %d: invalid extension checksum", i)
+		}
+		var tile *TiledDisplayTopology
+		for _, block := range decoded.Extensions[0].DisplayID.Sections[0].Blocks {
+			if block.TiledTopology != nil {
+				tile = block.TiledTopology
+			}
+		}
+		if tile == nil {
+			t.Fatalf("EDID %d: tiled display topology missing", i)
+		}
+		want := topology
+		want.HorizontalLocation = i % topology.HorizontalTiles
+		want.VerticalLocation = i / topology.HorizontalTiles
+		want.BezelInformation = true
+		if *tile != want {
+			t.Errorf("EDID %d: tiled display topology\ngot  %+v\nwant %+v", i, *tile, want)
+		}
+	}
+}
+
+func TestGenerateVideoWallExistingTopology(t *testing.T) {
+	base, err := ReadEDID(testBaseBlock(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	topology := TiledDisplayTopology{HorizontalTiles: 2, VerticalTiles: 1, TileWidth: 1920, TileHeight: 1080, VendorID: "00-0C-03"}
+	edids, err := GenerateVideoWall(&base, topology)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wall, err := ReadEDID(edids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenerateVideoWall(&wall, topology); err == nil {
+		t.Error("expected an error for a base EDID that already holds a tiled display topology")
+	}
+}
+
+func TestGenerateVideoWallWithoutPreferredTiming(t *testing.T) {
+	data := testBaseBlock(0)
+	// Swap the preferred timing and the monitor name descriptor
+	dtd := append([]byte{}, data[54:72]...)
+	copy(data[54:72], data[90:108])
+	copy(data[90:108], dtd)
+	data[EDID_SIZE-CHECKSUM_SIZE] = generateChecksum(data[:EDID_SIZE-CHECKSUM_SIZE])
+	base, err := ReadEDID(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	topology := TiledDisplayTopology{HorizontalTiles: 2, VerticalTiles: 1, TileWidth: 1280, TileHeight: 720, VendorID: "00-0C-03"}
+	if _, err := GenerateVideoWall(&base, topology); err == nil {
+		t.Error("expected an error for a base EDID without a preferred detailed timing")
+	}
+}
@@ -1,265 +1,135 @@
 package edid
 
 import (
-	"encoding/binary"
 	"fmt"
 )
 
-func parseTiledDisplayTopology(dd []byte) int {
-	fmt.Println("Parsing tiled display topology")
-	revision := dd[1]
-	fmt.Printf("\tRevision: 0x%02x\n", revision)
-	numberOfPayloadBytes := dd[2]
-	fmt.Printf("\tNumber of payload bytes: %d\n", numberOfPayloadBytes)
-
-	// display capabilities
-	caps := dd[3]
-	oneTileBehavior := caps & TILE_ONE_TILE_BEHAVIOR
-	nTileBehavior := caps & TILE_N_TILE_BEHAVIOR >> 3
-	bezelDescription := caps & TILE_BEZEL_DESCRIPTOR >> 6
-	physicalEnclosure := caps & TILE_PHYSICAL_ENCLOSURE >> 7
-
-	switch oneTileBehavior {
-	case 0x00:
-		fmt.Println("\tOne tile behavior: None of the following")
-	case 0x01:
-		fmt.Println("\tOne tile behavior: Display on tile", 0)
-	case 0x02:
-		fmt.Println("\tOne tile behavior: Scale to fit the display")
-	case 0x03:
-		fmt.Println("\tOne tile behavior: Clone to other tiles")
-	}
-
-	switch nTileBehavior {
-	case 0x00:
-		fmt.Println("\tN tile behavior: None of the following")
-	case 0x01:
-		fmt.Println("\tN tile behavior: Display on tile", 0)
+func parseShortVideoDescriptor(svd byte) ShortVideoDescriptor {
+	descriptor := ShortVideoDescriptor{VIC: int(svd)}
+	if svd >= CTA_SVD_NATIVE_MIN && svd <= CTA_SVD_NATIVE_MAX {
+		descriptor.VIC = int(svd & CTA_SVD_VIC_MASK)
+		descriptor.Native = true
 	}
-
-	if bezelDescription == 0x00 {
-		fmt.Println("\tBezel description: None bezel description")
-	} else {
-		fmt.Println("\tBezel description: ", bezelDescription)
-		// bytes 11 - 15
-	}
-
-	if physicalEnclosure == 0x00 {
-		fmt.Println("\tPhysical bezel: Multiple physical enclosures")
-	} else {
-		fmt.Println("\tPhysical bezel: Single physical enclosure")
+	if vic, ok := ctaVICTable[descriptor.VIC]; ok {
+		timing := vic.timing()
+		descriptor.AspectRatio = vic.aspectRatio
+		descriptor.Timing = &timing
 	}
-
-	// Tiled display topology
-	nrTilesLSB := dd[4]
-	locationTilesLSB := dd[5]
-	tilesMSB := dd[6]
-
-	print("\tNumber of tiles: ", nrTilesLSB, " ", tilesMSB, "\n")
-	nrVerticalTiles := ((tilesMSB & 0x03) | (nrTilesLSB & 0x0f)) + 1
-	nrHorizontalTiles := ((tilesMSB & 0x0c >> 2) | (nrTilesLSB & 0xf0 >> 4)) + 1
-	fmt.Printf("\tNumber of horizontal tiles: %d\n", nrHorizontalTiles)
-	fmt.Printf("\tNumber of vertical tiles: %d\n", nrVerticalTiles)
-
-	// tiled display location
-	verticalTileLocation := ((tilesMSB & 0x30) << 4) | (locationTilesLSB & 0x0f)
-	horizontalTileLocation := ((tilesMSB & 0xc0) >> 2) | (locationTilesLSB & 0xf0 >> 4)
-	fmt.Printf("\tVertical tile location: %d\n", verticalTileLocation)
-	fmt.Printf("\tHorizontal tile location: %d\n", horizontalTileLocation)
-
-	// tile size
-	horizontalSizeLSB := dd[7]
-	horizontalSizeMSB := dd[8]
-	horizontalSize := ((int(horizontalSizeMSB) << 8) | int(horizontalSizeLSB)) + 1
-
-	verticalSizeLSB := dd[9]
-	verticalSizeMSB := dd[10]
-	verticalSize := ((int(verticalSizeMSB) << 8) | int(verticalSizeLSB)) + 1
-
-	fmt.Printf("\tTile size: %d x %d\n", horizontalSize, verticalSize)
-
-	// Tile pixel multiplier and bezel information
-	// 0xB -> 0x0F
-
-	//Tile diplay topology id
-	// 0x10 -> 0x18
-	var tiledDisplayVendorID [3]byte
-	var tiledDisplayProductID [2]byte
-	var tiledDisplaySerialNumber [4]byte
-	copy(tiledDisplayVendorID[:], dd[16:19])
-	copy(tiledDisplayProductID[:], dd[19:21])
-	copy(tiledDisplaySerialNumber[:], dd[21:25])
-	fmt.Printf("\tTiled display vendor ID: %s\n", tiledDisplayVendorID)
-	fmt.Printf("\tTiled display product ID: %d\n", binary.LittleEndian.Uint16(tiledDisplayProductID[:]))
-	fmt.Printf("\tTiled display serial number: %d\n", binary.LittleEndian.Uint32(tiledDisplaySerialNumber[:]))
-
-	return 3 + int(numberOfPayloadBytes)
+	return descriptor
 }
 
-func parseVTBType1(vtb []byte) int {
-	fmt.Println("Parsing VTB type 1")
-	revision := vtb[1]
-	fmt.Printf("\tRevision: 0x%02x\n", revision)
-	numberOfPayloadBytes := vtb[2]
-	fmt.Printf("\tNumber of payload bytes: %d\n", numberOfPayloadBytes)
-	fmt.Printf("\tNumber of video timing blocks: %d\n", numberOfPayloadBytes/CTA_VTB_TYPE_1_DESCRIPTOR_SIZE)
-
-	offset := 3
-	for i := 0; i < int(numberOfPayloadBytes/CTA_VTB_TYPE_1_DESCRIPTOR_SIZE); i++ {
-		var vtd VTBDescriptor
-		// Video timing block descriptor
-		fmt.Printf("Video timing block %d\n", i+1)
-		vtd.pixelClockLBits = vtb[offset+0] // Pixel clock low bits
-		vtd.pixelClockMBits = vtb[offset+1] // Pixel clock middle bits
-		vtd.pixelClockHBits = vtb[offset+2] // Pixel clock high bits
-		pixelClock := float64((int(vtd.pixelClockHBits)<<16)|(int(vtd.pixelClockMBits)<<8)|int(vtd.pixelClockLBits)) / 100.0
-		fmt.Printf("\tPixel clock: %fMHz\n", pixelClock)
-
-		vtd.timingOptions = vtb[offset+3]
-		fmt.Printf("\tTiming options: 0x%02x\n", vtd.timingOptions)
-
-		vtd.hActiveLSB = vtb[offset+4] // Horizontal active low bits
-		vtd.hActiveMSB = vtb[offset+5] // Horizontal active high bits
-		hActive := (int(vtd.hActiveMSB)<<8 | int(vtd.hActiveLSB)) + 1
-
-		vtd.hBlankingLSB = vtb[offset+6] // Horizontal blanking low bits
-		vtd.hBlankingMSB = vtb[offset+7] // Horizontal blanking high bits
-		hBlanking := (int(vtd.hBlankingMSB)<<8 | int(vtd.hBlankingLSB)) + 1
-
-		vtd.hFrontPorchLSB = vtb[offset+8]        // Horizontal front porch low bits
-		vtd.hFrontPorchMSB = vtb[offset+9] & 0x7F // Horizontal front porch high bits
-		hFrontPorch := (int(vtd.hFrontPorchMSB)<<8 | int(vtd.hFrontPorchLSB)) + 1
-
-		hSyncPol := vtb[offset+9] & 0x80 >> 7 // Horizontal sync polarity
-		hSyncPolStr := "P"
-		if hSyncPol == 0 {
-			hSyncPolStr = "N"
-		}
-		vtd.hSyncWidthLSB = vtb[offset+10] // Horizontal sync width low bits
-		vtd.hSyncWidthMSB = vtb[offset+11] // Horizontal sync width high bits
-		hSyncWidth := (int(vtd.hSyncWidthMSB)<<8 | int(vtd.hSyncWidthLSB)) + 1
-
-		hBackPorch := hBlanking - hFrontPorch - hSyncWidth
-
-		fmt.Printf("\tha: %d, hbl: %d, hfp: %d, hbp; %d, hsync: %d, Hpol %s\n", hActive, hBlanking, hFrontPorch, hBackPorch, hSyncWidth, hSyncPolStr)
-
-		vtd.vActiveLSB = vtb[offset+12] // Vertical active low bits
-		vtd.vActiveMSB = vtb[offset+13] // Vertical active high bits
-		vActive := (int(vtd.vActiveMSB)<<8 | int(vtd.vActiveLSB)) + 1
-
-		vtd.vBlankingLSB = vtb[offset+14] // Vertical blanking low bits
-		vtd.vBlankingMSB = vtb[offset+15] // Vertical blanking high bits
-		vBlanking := (int(vtd.vBlankingMSB)<<8 | int(vtd.vBlankingLSB)) + 1
-
-		vtd.vFrontPorchLSB = vtb[offset+16]        // Vertical front porch low bits
-		vtd.vFrontPorchMSB = vtb[offset+17] & 0x7F // Vertical front porch high bits
-		vFrontPorch := (int(vtd.vFrontPorchMSB)<<8 | int(vtd.vFrontPorchLSB)) + 1
-
-		vSyncPol := vtb[offset+17] & 0x80 >> 7 // Vertical sync polarity
-		vSyncPolStr := "P"
-		if vSyncPol == 0 {
-			vSyncPolStr = "N"
+func parseVideoDataBlock(payload []byte, warnings *[]string) []ShortVideoDescriptor {
+	descriptors := make([]ShortVideoDescriptor, 0, len(payload))
+	for _, svd := range payload {
+		descriptor := parseShortVideoDescriptor(svd)
+		if descriptor.Timing == nil {
+			*warnings = append(*warnings, fmt.Sprintf("Unknown VIC %d in video data block", descriptor.VIC))
 		}
-		vtd.vSyncWidthLSB = vtb[offset+18] // Vertical sync width low bits
-		vtd.vSyncWidthMSB = vtb[offset+19] // Vertical sync width high bits
-		vSyncWidth := (int(vtd.vSyncWidthMSB)<<8 | int(vtd.vSyncWidthLSB)) + 1
-
-		vBackPorch := vBlanking - vFrontPorch - vSyncWidth
-
-		htotal := hActive + hBlanking
-		vtotal := vActive + vBlanking
-		refRate := float64(pixelClock*1000*1000) / float64(htotal*vtotal)
-		fmt.Printf("\tva: %d, vbl: %d, vfp: %d, vbp; %d, vsync: %d, Vpol %s\n", vActive, vBlanking, vFrontPorch, vBackPorch, vSyncWidth, vSyncPolStr)
-		fmt.Printf("\tTotal: %d x %d, refresh rate: %fHz\n", htotal, vtotal, refRate)
-
-		offset += CTA_VTB_TYPE_1_DESCRIPTOR_SIZE
+		descriptors = append(descriptors, descriptor)
 	}
-
-	return 3 + int(numberOfPayloadBytes)
+	return descriptors
 }
 
-func (edid *EDID) DisplayIDCheckSum() int {
-	var sum byte
-	for i := 1; i < CTA_SIZE-3; i++ {
-		sum += edid.ctaData[i]
+func parseCTADataBlock(data []byte, warnings *[]string) CTADataBlock {
+	block := CTADataBlock{
+		Tag:    (data[0] & CTA_EXT_TAG_CODE_MASK) >> 5,
+		Length: int(data[0] & CTA_EXT_LENGTH_MASK),
 	}
-	return 256 - int(sum)
-}
-
-func (edid *EDID) CTACheckSum() int {
-	var sum byte
-	for i := 0; i < CTA_SIZE-1; i++ {
-		sum += edid.ctaData[i]
+	block.Payload = append([]byte(nil), data[1:1+block.Length]...)
+	if block.Tag == CTA_EXT_TAG_USE_EXTENDED_TAG && block.Length > 0 {
+		block.ExtendedTag = block.Payload[0]
 	}
-	return 256 - int(sum)
-}
-
-func (edid *EDID) ParseCTA(warnings *[]string) error {
-	fmt.Println("Parsing CTA extension")
-
-	fmt.Println("Parsing DisplayID extension")
-	blockType := edid.ctaData[0] >> 4
-	totalLength := edid.ctaData[0] & 0x0f
-	fmt.Printf("Block type: 0x%02x\n", blockType)
-	fmt.Printf("Total length: %d\n", totalLength)
-	switch blockType {
+	switch block.Tag {
 	case CTA_EXT_TAG_AUDIO_DATA_BLOCK:
-		fmt.Println("Audio data block")
+		block.AudioDescriptors = parseAudioDataBlock(block.Payload, warnings)
 	case CTA_EXT_TAG_VIDEO_DATA_BLOCK:
-		fmt.Println("Video data block")
+		block.VideoDescriptors = parseVideoDataBlock(block.Payload, warnings)
 	case CTA_EXT_TAG_VENDOR_SPECIFIC_DATA_BLOCK:
-		fmt.Println("Vendor-specific data block")
+		parseVendorSpecificDataBlock(&block, warnings)
 	case CTA_EXT_TAG_SPEAKER_ALLOCATION_DATA_BLOCK:
-		fmt.Println("Speaker allocation data block")
-	case CTA_EXT_TAG_VESA_DTC_DATA_BLOCK:
-		fmt.Println("VESA DTC data block")
-	case CTA_EXT_TAG_VIDEO_FORMAT_DATA_BLOCK:
-		fmt.Println("Video format data block")
+		block.Speakers = parseSpeakerAllocation(block.Payload, warnings)
 	case CTA_EXT_TAG_USE_EXTENDED_TAG:
-		fmt.Println("Use extended tag")
+		parseExtendedDataBlock(&block, warnings)
+	}
+	return block
+}
+
+func parseExtendedDataBlock(block *CTADataBlock, warnings *[]string) {
+	if block.Length == 0 {
+		*warnings = append(*warnings, "Extended tag data block has no extended tag code")
+		return
+	}
+	switch block.ExtendedTag {
+	case CTA_EXTENDED_TAG_VIDEO_CAPABILITY_DATA_BLOCK:
+		block.VideoCapability = parseVideoCapabilityDataBlock(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_COLORIMETRY_DATA_BLOCK:
+		block.Colorimetry = parseColorimetryDataBlock(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_YCBCR420_VIDEO_DATA_BLOCK:
+		block.VideoDescriptors = parseVideoDataBlock(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_YCBCR420_CAPABILITY_MAP_DATA_BLOCK:
+		block.YCbCr420MapAll = block.Length == 1
+		block.YCbCr420Map = parseYCbCr420CapabilityMap(block.Payload[1:])
+	case CTA_EXTENDED_TAG_HDR_STATIC_METADATA_DATA_BLOCK:
+		block.HDRStatic = parseHDRStaticMetadata(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_HDR_DYNAMIC_METADATA_DATA_BLOCK:
+		block.HDRDynamic = parseHDRDynamicMetadata(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_ROOM_CONFIGURATION_DATA_BLOCK:
+		block.RoomConfig = parseRoomConfiguration(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_SPEAKER_LOCATION_DATA_BLOCK:
+		block.SpeakerLocations = parseSpeakerLocations(block.Payload[1:], warnings)
+	case CTA_EXTENDED_TAG_HF_SCDB_DATA_BLOCK:
+		parseHDMIForumSCDB(block, warnings)
+	}
+}
+
+func decodeCTAExtension(extBlock *ExtensionBlock, warnings *[]string) (*CTAExtension, error) {
+	var cta CTAExtension
+	data := extBlock.data[:]
+
+	offset := CTA_EXT_TAG_SIZE
+	cta.Revision = int(data[offset])
+	offset += CTA_EXT_REVISION_SIZE
+	cta.DTDOffset = int(data[offset])
+	offset += CTA_EXT_DTD_START_SIZE
+	if cta.Revision >= 2 {
+		flags := data[offset]
+		cta.Underscan = flags&CTA_EXT_UNDERSCAN != 0
+		cta.BasicAudio = flags&CTA_EXT_BASIC_AUDIO != 0
+		cta.YCbCr444 = flags&CTA_EXT_YCBCR444 != 0
+		cta.YCbCr422 = flags&CTA_EXT_YCBCR422 != 0
+		cta.NativeDTDs = int(flags & CTA_EXT_NATIVE_DTDS_MASK)
+	}
+	offset += CTA_EXT_NR_OF_DTDS_SIZE
+
+	// A DTD offset of 0 means there are neither data blocks nor DTDs
+	if cta.DTDOffset == 0 {
+		return &cta, nil
+	}
+	if cta.DTDOffset < offset || cta.DTDOffset > EXTENSION_SIZE-CHECKSUM_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("CTA-861 DTD offset 0x%02x is invalid", cta.DTDOffset))
+		return &cta, nil
 	}
 
-	dpidRev := edid.ctaData[1]
-	fmt.Printf("DisplayID revision: 0x%02x\n", dpidRev)
-	if dpidRev != 0x13 && dpidRev != 0x20 {
-		*warnings = append(*warnings, fmt.Sprintf("DisplayID revision 0x%02x is invalid or unsupported", dpidRev))
+	// Data block collection, only present from revision 3 onwards
+	for cta.Revision >= 3 && offset < cta.DTDOffset {
+		length := int(data[offset] & CTA_EXT_LENGTH_MASK)
+		if offset+1+length > cta.DTDOffset {
+			*warnings = append(*warnings, fmt.Sprintf("CTA-861 data block at offset 0x%02x exceeds the data block collection", offset))
+			break
+		}
+		cta.DataBlocks = append(cta.DataBlocks, parseCTADataBlock(data[offset:offset+1+length], warnings))
+		offset += 1 + length
 	}
-	dpidVariableLength := edid.ctaData[2]
-	fmt.Printf("DisplayID variable length: 0x%02x\n", dpidVariableLength)
-	primaryUseCase := edid.ctaData[3]
-	fmt.Printf("Primary use case: 0x%02x\n", primaryUseCase)
-	extCount := edid.ctaData[4]
-	fmt.Printf("Extension count: 0x%02x\n", extCount)
+	applyYCbCr420CapabilityMap(&cta, warnings)
 
-	offset := 5
-	done := false
-	for !done {
-		blockTypeTag := edid.ctaData[offset]
-		fmt.Printf("Block type tag @ 0x%02x: 0x%02x\n", offset, blockTypeTag)
-		switch blockTypeTag {
-		case CTA_BLOCK_TILED_DISPLAY, CTA_BLOCK_TILED_DISPLAY_LEGACY:
-			if blockTypeTag == CTA_BLOCK_TILED_DISPLAY_LEGACY {
-				*warnings = append(*warnings, "Tiled display block (0x12) is deprecated and superseded by Tiled display block (0x28)")
-			}
-			displayData := edid.ctaData[offset : offset+CTA_BLOCK_TILED_SIZE]
-			payloadSize := parseTiledDisplayTopology(displayData)
-			offset += payloadSize
-		case CTA_BLOCK_VTB_TYPE_1:
-			fmt.Println("VTB type 1")
-			*warnings = append(*warnings, "VTB Type 1 (0x03) is deprecated and superseded by VTB Type 7 (0x22)")
-			vtdData := edid.ctaData[offset:]
-			payloadSize := parseVTBType1(vtdData)
-			offset += payloadSize
-		case 0x00:
-			fmt.Println("End of CTA extension")
-			done = true
-		default:
-			fmt.Println("Unknown block type")
+	for offset = cta.DTDOffset; offset+DISPLAY_DESCRIPTOR_SIZE <= EXTENSION_SIZE-CHECKSUM_SIZE; offset += DISPLAY_DESCRIPTOR_SIZE {
+		if data[offset] == 0x00 && data[offset+1] == 0x00 {
+			break
 		}
+		var dd [DISPLAY_DESCRIPTOR_SIZE]byte
+		copy(dd[:], data[offset:offset+DISPLAY_DESCRIPTOR_SIZE])
+		cta.DetailedTimings = append(cta.DetailedTimings, parseDisplayTimingDescriptor(dd))
 	}
-	displayIDCheckSum := edid.ctaData[CTA_SIZE-2]
-	CTAExtCheckSum := edid.ctaData[CTA_SIZE-1]
-	fmt.Printf("DisplayID checksum: 0x%02x is: %t\n", displayIDCheckSum, edid.DisplayIDCheckSum() == int(displayIDCheckSum))
-	fmt.Printf("CTA extension checksum: 0x%02x is valid: %t\n", CTAExtCheckSum, edid.CTACheckSum() == int(CTAExtCheckSum))
-	return nil
+
+	return &cta, nil
 }
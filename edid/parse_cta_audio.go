@@ -0,0 +1,321 @@
+package edid
+
+import (
+	"fmt"
+)
+
+var sadSampleRates = []int{32000, 44100, 48000, 88200, 96000, 176400, 192000}
+
+var sadBitDepths = []int{16, 20, 24}
+
+func audioFormatName(format int, extensionType int) string {
+	switch format {
+	case CTA_AUDIO_FORMAT_LPCM:
+		return "L-PCM"
+	case CTA_AUDIO_FORMAT_AC3:
+		return "AC-3"
+	case CTA_AUDIO_FORMAT_MPEG1:
+		return "MPEG-1 (Layers 1 & 2)"
+	case CTA_AUDIO_FORMAT_MP3:
+		return "MP3"
+	case CTA_AUDIO_FORMAT_MPEG2:
+		return "MPEG-2 Multichannel"
+	case CTA_AUDIO_FORMAT_AAC_LC:
+		return "AAC LC"
+	case CTA_AUDIO_FORMAT_DTS:
+		return "DTS"
+	case CTA_AUDIO_FORMAT_ATRAC:
+		return "ATRAC"
+	case CTA_AUDIO_FORMAT_ONE_BIT:
+		return "One Bit Audio"
+	case CTA_AUDIO_FORMAT_EAC3:
+		return "Enhanced AC-3 (DD+)"
+	case CTA_AUDIO_FORMAT_DTS_HD:
+		return "DTS-HD"
+	case CTA_AUDIO_FORMAT_MAT:
+		return "MAT (MLP)"
+	case CTA_AUDIO_FORMAT_DST:
+		return "DST"
+	case CTA_AUDIO_FORMAT_WMA_PRO:
+		return "WMA Pro"
+	case CTA_AUDIO_FORMAT_EXTENSION:
+		switch extensionType {
+		case CTA_AUDIO_EXT_TYPE_HE_AAC:
+			return "MPEG-4 HE AAC"
+		case CTA_AUDIO_EXT_TYPE_HE_AAC_V2:
+			return "MPEG-4 HE AAC v2"
+		case CTA_AUDIO_EXT_TYPE_AAC_LC:
+			return "MPEG-4 AAC LC"
+		case CTA_AUDIO_EXT_TYPE_DRA:
+			return "DRA"
+		case CTA_AUDIO_EXT_TYPE_HE_AAC_MPS:
+			return "MPEG-4 HE AAC + MPEG Surround"
+		case CTA_AUDIO_EXT_TYPE_AAC_LC_MPS:
+			return "MPEG-4 AAC LC + MPEG Surround"
+		case CTA_AUDIO_EXT_TYPE_MPEGH_3D:
+			return "MPEG-H 3D Audio"
+		case CTA_AUDIO_EXT_TYPE_AC4:
+			return "AC-4"
+		case CTA_AUDIO_EXT_TYPE_LPCM_3D:
+			return "L-PCM 3D Audio"
+		default:
+			return fmt.Sprintf("Reserved extension type %d", extensionType)
+		}
+	default:
+		return fmt.Sprintf("Reserved format %d", format)
+	}
+}
+
+func parseShortAudioDescriptor(sad []byte) ShortAudioDescriptor {
+	descriptor := ShortAudioDescriptor{
+		Format:      int(sad[0]&CTA_SAD_FORMAT_MASK) >> 3,
+		MaxChannels: int(sad[0]&CTA_SAD_CHANNELS_MASK) + 1,
+		FormatValue: sad[2],
+	}
+	if descriptor.Format == CTA_AUDIO_FORMAT_EXTENSION {
+		descriptor.ExtensionType = int(sad[2]&CTA_SAD_EXTENSION_TYPE_MASK) >> 3
+	}
+	descriptor.FormatName = audioFormatName(descriptor.Format, descriptor.ExtensionType)
+
+	for i, rate := range sadSampleRates {
+		if sad[1]&CTA_SAD_SAMPLE_RATES_MASK&(1<<i) != 0 {
+			descriptor.SampleRates = append(descriptor.SampleRates, rate)
+		}
+	}
+
+	switch descriptor.Format {
+	case CTA_AUDIO_FORMAT_LPCM:
+		descriptor.BitDepths = parseSADBitDepths(sad[2])
+	case CTA_AUDIO_FORMAT_AC3, CTA_AUDIO_FORMAT_MPEG1, CTA_AUDIO_FORMAT_MP3, CTA_AUDIO_FORMAT_MPEG2,
+		CTA_AUDIO_FORMAT_AAC_LC, CTA_AUDIO_FORMAT_DTS, CTA_AUDIO_FORMAT_ATRAC:
+		descriptor.MaxBitrate = int(sad[2]) * 8
+	case CTA_AUDIO_FORMAT_EAC3:
+		if sad[2]&CTA_SAD_EAC3_JOC != 0 {
+			descriptor.Flags = append(descriptor.Flags, "Supports Joint Object Coding")
+		}
+		if sad[2]&CTA_SAD_EAC3_JOC_ACMOD28 != 0 {
+			descriptor.Flags = append(descriptor.Flags, "Supports Joint Object Coding with ACMOD28")
+		}
+	case CTA_AUDIO_FORMAT_MAT:
+		if sad[2]&CTA_SAD_MAT_OBJECT_AUDIO != 0 {
+			descriptor.Flags = append(descriptor.Flags, "Supports Dolby TrueHD, object audio PCM and channel-based PCM")
+			if sad[2]&CTA_SAD_MAT_HASH_NOT_REQUIRED != 0 {
+				descriptor.Flags = append(descriptor.Flags, "Hash calculation not required for object audio PCM or channel-based PCM")
+			} else {
+				descriptor.Flags = append(descriptor.Flags, "Hash calculation required for object audio PCM or channel-based PCM")
+			}
+		} else {
+			descriptor.Flags = append(descriptor.Flags, "Supports only Dolby TrueHD")
+		}
+	case CTA_AUDIO_FORMAT_WMA_PRO:
+		descriptor.Flags = append(descriptor.Flags, fmt.Sprintf("Profile %d", sad[2]&0x07))
+	case CTA_AUDIO_FORMAT_EXTENSION:
+		switch descriptor.ExtensionType {
+		case CTA_AUDIO_EXT_TYPE_HE_AAC, CTA_AUDIO_EXT_TYPE_HE_AAC_V2, CTA_AUDIO_EXT_TYPE_AAC_LC,
+			CTA_AUDIO_EXT_TYPE_HE_AAC_MPS, CTA_AUDIO_EXT_TYPE_AAC_LC_MPS:
+			if sad[2]&CTA_SAD_AAC_FRAME_960 != 0 {
+				descriptor.Flags = append(descriptor.Flags, "AAC audio frame lengths 960 samples")
+			}
+			if sad[2]&CTA_SAD_AAC_FRAME_1024 != 0 {
+				descriptor.Flags = append(descriptor.Flags, "AAC audio frame lengths 1024 samples")
+			}
+			if descriptor.ExtensionType == CTA_AUDIO_EXT_TYPE_HE_AAC_MPS || descriptor.ExtensionType == CTA_AUDIO_EXT_TYPE_AAC_LC_MPS {
+				if sad[2]&CTA_SAD_MPS_EXPLICIT != 0 {
+					descriptor.Flags = append(descriptor.Flags, "Supports explicitly signaled MPEG Surround data")
+				} else {
+					descriptor.Flags = append(descriptor.Flags, "Supports only implicitly signaled MPEG Surround data")
+				}
+			}
+		case CTA_AUDIO_EXT_TYPE_MPEGH_3D:
+			// The channel count is not signalled for MPEG-H 3D Audio
+			descriptor.MaxChannels = 0
+			level := sad[2] & CTA_SAD_MPEGH_LEVEL_MASK
+			if level == 0 {
+				descriptor.Flags = append(descriptor.Flags, "MPEG-H 3D Audio Level: Unspecified")
+			} else {
+				descriptor.Flags = append(descriptor.Flags, fmt.Sprintf("MPEG-H 3D Audio Level: %d", level))
+			}
+		case CTA_AUDIO_EXT_TYPE_AC4:
+			// The channel count is not signalled for AC-4
+			descriptor.MaxChannels = 0
+		case CTA_AUDIO_EXT_TYPE_LPCM_3D:
+			channels := int(sad[0] & CTA_SAD_CHANNELS_MASK)
+			if sad[1]&CTA_SAD_PCM_3D_CHANNEL_BIT3 != 0 {
+				channels |= 0x08
+			}
+			if sad[0]&CTA_SAD_PCM_3D_CHANNEL_BIT4 != 0 {
+				channels |= 0x10
+			}
+			descriptor.MaxChannels = channels + 1
+			descriptor.BitDepths = parseSADBitDepths(sad[2])
+		}
+	}
+	return descriptor
+}
+
+func parseSADBitDepths(b byte) []int {
+	depths := make([]int, 0)
+	for i, depth := range sadBitDepths {
+		if b&CTA_SAD_LPCM_BIT_DEPTHS_MASK&(1<<i) != 0 {
+			depths = append(depths, depth)
+		}
+	}
+	return depths
+}
+
+func parseAudioDataBlock(payload []byte, warnings *[]string) []ShortAudioDescriptor {
+	if len(payload)%CTA_SAD_SIZE != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("Audio data block length %d is not a multiple of %d", len(payload), CTA_SAD_SIZE))
+	}
+	descriptors := make([]ShortAudioDescriptor, 0, len(payload)/CTA_SAD_SIZE)
+	for offset := 0; offset+CTA_SAD_SIZE <= len(payload); offset += CTA_SAD_SIZE {
+		descriptors = append(descriptors, parseShortAudioDescriptor(payload[offset:offset+CTA_SAD_SIZE]))
+	}
+	return descriptors
+}
+
+// Speaker allocation bits, from bit 0 of the first byte to bit 4 of the third byte
+var ctaSpeakerAllocation = []string{
+	"FL/FR - Front Left/Right",
+	"LFE1 - Low Frequency Effects 1",
+	"FC - Front Center",
+	"BL/BR - Back Left/Right",
+	"BC - Back Center",
+	"FLc/FRc - Front Left/Right of Center",
+	"RLC/RRC - Rear Left/Right of Center (deprecated)",
+	"FLw/FRw - Front Left/Right Wide",
+	"TpFL/TpFR - Top Front Left/Right",
+	"TpC - Top Center",
+	"TpFC - Top Front Center",
+	"LS/RS - Left/Right Surround",
+	"LFE2 - Low Frequency Effects 2",
+	"TpBC - Top Back Center",
+	"SiL/SiR - Side Left/Right",
+	"TpSiL/TpSiR - Top Side Left/Right",
+	"TpBL/TpBR - Top Back Left/Right",
+	"BtFC - Bottom Front Center",
+	"BtFL/BtFR - Bottom Front Left/Right",
+	"TpLS/TpRS - Top Left/Right Surround (deprecated)",
+	"LSd/RSd - Left/Right Surround Direct",
+}
+
+var ctaSpeakerIDs = []string{
+	"FL - Front Left",
+	"FR - Front Right",
+	"FC - Front Center",
+	"LFE1 - Low Frequency Effects 1",
+	"BL - Back Left",
+	"BR - Back Right",
+	"FLC - Front Left of Center",
+	"FRC - Front Right of Center",
+	"BC - Back Center",
+	"LFE2 - Low Frequency Effects 2",
+	"SiL - Side Left",
+	"SiR - Side Right",
+	"TpFL - Top Front Left",
+	"TpFR - Top Front Right",
+	"TpFC - Top Front Center",
+	"TpC - Top Center",
+	"TpBL - Top Back Left",
+	"TpBR - Top Back Right",
+	"TpSiL - Top Side Left",
+	"TpSiR - Top Side Right",
+	"TpBC - Top Back Center",
+	"BtFC - Bottom Front Center",
+	"BtFL - Bottom Front Left",
+	"BtFR - Bottom Front Right",
+	"FLW - Front Left Wide",
+	"FRW - Front Right Wide",
+	"LS - Left Surround",
+	"RS - Right Surround",
+}
+
+func parseSpeakerAllocation(payload []byte, warnings *[]string) []string {
+	if len(payload) < CTA_SPEAKER_ALLOCATION_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("Speaker allocation length %d is too short", len(payload)))
+		return nil
+	}
+	allocation := uint32(payload[2])<<16 | uint32(payload[1])<<8 | uint32(payload[0])
+	speakers := make([]string, 0)
+	for i, speaker := range ctaSpeakerAllocation {
+		if allocation&(1<<i) != 0 {
+			speakers = append(speakers, speaker)
+		}
+	}
+	if allocation>>len(ctaSpeakerAllocation) != 0 {
+		*warnings = append(*warnings, "Speaker allocation has reserved bits set")
+	}
+	return speakers
+}
+
+// Room and speaker coordinates are encoded as value/64 - 1, a fraction of the room maxima
+func speakerCoordinate(value byte) float64 {
+	return float64(value)/64.0 - 1.0
+}
+
+func parseRoomConfiguration(payload []byte, warnings *[]string) *RoomConfiguration {
+	if len(payload) < CTA_RCDB_MIN_LEN {
+		*warnings = append(*warnings, fmt.Sprintf("Room configuration data block length %d is too short", len(payload)))
+		return nil
+	}
+	var room RoomConfiguration
+	flags := payload[0]
+	if flags&CTA_RCDB_SPEAKER != 0 {
+		room.SpeakerCount = int(flags&CTA_RCDB_SPEAKER_COUNT_MASK) + 1
+	}
+	room.SpeakerLocationsPresent = flags&CTA_RCDB_SLD != 0
+	room.DisplayPresent = flags&CTA_RCDB_DISPLAY != 0
+	room.Speakers = parseSpeakerAllocation(payload[1:], warnings)
+
+	if len(payload) >= CTA_RCDB_MAX_SIZE {
+		room.MaxX = int(payload[4])
+		room.MaxY = int(payload[5])
+		room.MaxZ = int(payload[6])
+	}
+	if room.DisplayPresent {
+		if len(payload) < CTA_RCDB_DISPLAY_SIZE {
+			*warnings = append(*warnings, "Room configuration data block is truncated in the display location")
+			room.DisplayPresent = false
+			return &room
+		}
+		room.DisplayX = speakerCoordinate(payload[7])
+		room.DisplayY = speakerCoordinate(payload[8])
+		room.DisplayZ = speakerCoordinate(payload[9])
+	}
+	return &room
+}
+
+func parseSpeakerLocations(payload []byte, warnings *[]string) []SpeakerLocation {
+	var locations []SpeakerLocation
+	for offset := 0; offset < len(payload); {
+		if offset+CTA_SLD_MIN_SIZE > len(payload) {
+			*warnings = append(*warnings, "Speaker location descriptor is truncated")
+			break
+		}
+		location := SpeakerLocation{
+			ChannelIndex:       int(payload[offset] & CTA_SLD_CHANNEL_MASK),
+			Active:             payload[offset]&CTA_SLD_ACTIVE != 0,
+			CoordinatesPresent: payload[offset]&CTA_SLD_COORD != 0,
+			SpeakerID:          int(payload[offset+1] & CTA_SLD_SPEAKER_ID_MASK),
+		}
+		if location.SpeakerID < len(ctaSpeakerIDs) {
+			location.Speaker = ctaSpeakerIDs[location.SpeakerID]
+		} else {
+			location.Speaker = fmt.Sprintf("Reserved (%d)", location.SpeakerID)
+		}
+		size := CTA_SLD_MIN_SIZE
+		if location.CoordinatesPresent {
+			size = CTA_SLD_COORD_SIZE
+			if offset+size > len(payload) {
+				*warnings = append(*warnings, "Speaker location descriptor is truncated in the coordinates")
+				break
+			}
+			location.X = speakerCoordinate(payload[offset+2])
+			location.Y = speakerCoordinate(payload[offset+3])
+			location.Z = speakerCoordinate(payload[offset+4])
+		}
+		locations = append(locations, location)
+		offset += size
+	}
+	return locations
+}
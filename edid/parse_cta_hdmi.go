@@ -0,0 +1,323 @@
+package edid
+
+import (
+	"fmt"
+)
+
+// HDMI VICs map onto the equivalent CTA-861 VICs
+var hdmiVICs = map[int]int{
+	1: 95, // 3840x2160p @ 30Hz
+	2: 94, // 3840x2160p @ 25Hz
+	3: 93, // 3840x2160p @ 24Hz
+	4: 98, // 4096x2160p @ 24Hz
+}
+
+var hdmiContentTypes = []string{"Graphics", "Photo", "Cinema", "Game"}
+
+func hdmi3DStructureName(structure int) string {
+	switch structure {
+	case 0x00:
+		return "Frame packing"
+	case 0x01:
+		return "Field alternative"
+	case 0x02:
+		return "Line alternative"
+	case 0x03:
+		return "Side-by-side (full)"
+	case 0x04:
+		return "L + depth"
+	case 0x05:
+		return "L + depth + graphics + graphics-depth"
+	case 0x06:
+		return "Top-and-bottom"
+	case HDMI_3D_STRUCTURE_SBS_HALF:
+		return "Side-by-side (half)"
+	default:
+		return fmt.Sprintf("Reserved (%d)", structure)
+	}
+}
+
+func parseOUI(payload []byte) uint32 {
+	return uint32(payload[2])<<16 | uint32(payload[1])<<8 | uint32(payload[0])
+}
+
+func parseHDMILatency(latency byte) int {
+	switch latency {
+	case HDMI_VSDB_LATENCY_UNKNOWN:
+		return 0
+	case HDMI_VSDB_LATENCY_UNSUPPORTED:
+		return -1
+	default:
+		return (int(latency) - 1) * 2
+	}
+}
+
+func parseHDMIVSDB(payload []byte, warnings *[]string) *HDMIVSDB {
+	if len(payload) < HDMI_VSDB_MIN_LEN {
+		*warnings = append(*warnings, fmt.Sprintf("HDMI VSDB length %d is too short", len(payload)))
+		return nil
+	}
+	var vsdb HDMIVSDB
+	vsdb.PhysicalAddress = fmt.Sprintf("%d.%d.%d.%d", payload[3]>>4, payload[3]&0x0F, payload[4]>>4, payload[4]&0x0F)
+
+	offset := HDMI_VSDB_MIN_LEN
+	if offset >= len(payload) {
+		return &vsdb
+	}
+	flags := payload[offset]
+	vsdb.SupportsAI = flags&HDMI_VSDB_SUPPORTS_AI != 0
+	vsdb.DeepColor48 = flags&HDMI_VSDB_DC_48BIT != 0
+	vsdb.DeepColor36 = flags&HDMI_VSDB_DC_36BIT != 0
+	vsdb.DeepColor30 = flags&HDMI_VSDB_DC_30BIT != 0
+	vsdb.DeepColorY444 = flags&HDMI_VSDB_DC_Y444 != 0
+	vsdb.DVIDual = flags&HDMI_VSDB_DVI_DUAL != 0
+	offset++
+
+	if offset >= len(payload) {
+		return &vsdb
+	}
+	vsdb.MaxTMDSClock = int(payload[offset]) * 5
+	offset++
+
+	if offset >= len(payload) {
+		return &vsdb
+	}
+	flags = payload[offset]
+	vsdb.LatencyPresent = flags&HDMI_VSDB_LATENCY_PRESENT != 0
+	vsdb.InterlacedLatency = flags&HDMI_VSDB_I_LATENCY_PRESENT != 0
+	vsdb.HDMIVideoPresent = flags&HDMI_VSDB_HDMI_VIDEO_PRESENT != 0
+	for i, contentType := range hdmiContentTypes {
+		if flags&HDMI_VSDB_CNC_MASK&(1<<i) != 0 {
+			vsdb.ContentTypes = append(vsdb.ContentTypes, contentType)
+		}
+	}
+	offset++
+
+	if vsdb.LatencyPresent {
+		if offset+2 > len(payload) {
+			*warnings = append(*warnings, "HDMI VSDB is truncated in the latency fields")
+			return &vsdb
+		}
+		vsdb.VideoLatency = parseHDMILatency(payload[offset])
+		vsdb.AudioLatency = parseHDMILatency(payload[offset+1])
+		offset += 2
+	}
+	if vsdb.InterlacedLatency {
+		if offset+2 > len(payload) {
+			*warnings = append(*warnings, "HDMI VSDB is truncated in the interlaced latency fields")
+			return &vsdb
+		}
+		vsdb.InterlacedVideoLatency = parseHDMILatency(payload[offset])
+		vsdb.InterlacedAudioLatency = parseHDMILatency(payload[offset+1])
+		offset += 2
+	}
+	if !vsdb.HDMIVideoPresent {
+		return &vsdb
+	}
+
+	if offset+2 > len(payload) {
+		*warnings = append(*warnings, "HDMI VSDB is truncated in the HDMI video fields")
+		return &vsdb
+	}
+	flags = payload[offset]
+	vsdb.Present3D = flags&HDMI_VSDB_3D_PRESENT != 0
+	vsdb.Multi3D = int(flags&HDMI_VSDB_3D_MULTI_MASK) >> 5
+	vsdb.ImageSize = int(flags&HDMI_VSDB_IMAGE_SIZE_MASK) >> 3
+	offset++
+	vicLength := int(payload[offset]&HDMI_VSDB_VIC_LEN_MASK) >> 5
+	length3D := int(payload[offset] & HDMI_VSDB_3D_LEN_MASK)
+	offset++
+
+	if offset+vicLength+length3D > len(payload) {
+		*warnings = append(*warnings, "HDMI VSDB is truncated in the HDMI VIC and 3D fields")
+		return &vsdb
+	}
+	for i := 0; i < vicLength; i++ {
+		format := HDMIVideoFormat{HDMIVIC: int(payload[offset+i])}
+		if vic, ok := hdmiVICs[format.HDMIVIC]; ok {
+			timing := ctaVICTable[vic].timing()
+			format.VIC = vic
+			format.Timing = &timing
+		} else {
+			*warnings = append(*warnings, fmt.Sprintf("Unknown HDMI VIC %d", format.HDMIVIC))
+		}
+		vsdb.HDMIVICs = append(vsdb.HDMIVICs, format)
+	}
+	offset += vicLength
+
+	end := offset + length3D
+	if vsdb.Multi3D == 0x01 || vsdb.Multi3D == 0x02 {
+		if offset+2 > end {
+			*warnings = append(*warnings, "HDMI VSDB 3D_Structure_ALL field exceeds HDMI_3D_LEN")
+			return &vsdb
+		}
+		structures := uint16(payload[offset])<<8 | uint16(payload[offset+1])
+		for i := 0; i < 16; i++ {
+			if structures&(1<<i) != 0 {
+				vsdb.Structures3D = append(vsdb.Structures3D, hdmi3DStructureName(i))
+			}
+		}
+		offset += 2
+	}
+	if vsdb.Multi3D == 0x02 {
+		if offset+2 > end {
+			*warnings = append(*warnings, "HDMI VSDB 3D_MASK field exceeds HDMI_3D_LEN")
+			return &vsdb
+		}
+		vsdb.Mask3D = uint16(payload[offset])<<8 | uint16(payload[offset+1])
+		offset += 2
+	}
+	for offset < end {
+		entry := HDMI3DStructure{
+			SVDIndex:  int(payload[offset] >> 4),
+			Structure: int(payload[offset] & 0x0F),
+		}
+		entry.Name = hdmi3DStructureName(entry.Structure)
+		offset++
+		if entry.Structure >= HDMI_3D_STRUCTURE_SBS_HALF {
+			if offset >= end {
+				*warnings = append(*warnings, "HDMI VSDB 3D_Detail field exceeds HDMI_3D_LEN")
+				break
+			}
+			entry.Detail = int(payload[offset] >> 4)
+			offset++
+		}
+		vsdb.Entries3D = append(vsdb.Entries3D, entry)
+	}
+	return &vsdb
+}
+
+// FRL rate codes map onto the lane count and the per-lane rate in Gbit/s
+var hdmiFRLRates = map[int][2]int{
+	1: {3, 3},
+	2: {3, 6},
+	3: {4, 6},
+	4: {4, 8},
+	5: {4, 10},
+	6: {4, 12},
+}
+
+// DSC slice codes map onto the slice count and the maximum pixel clock per slice in MHz
+var hdmiDSCSlices = map[int][2]int{
+	1: {1, 340},
+	2: {2, 340},
+	3: {4, 340},
+	4: {8, 340},
+	5: {8, 400},
+	6: {12, 400},
+	7: {16, 400},
+}
+
+func parseHDMIForumSCDS(scds []byte, warnings *[]string) *HDMIForumSCDS {
+	if len(scds) < HF_SCDS_MIN_LEN {
+		*warnings = append(*warnings, fmt.Sprintf("HDMI Forum SCDS length %d is too short", len(scds)))
+		return nil
+	}
+	var hf HDMIForumSCDS
+	hf.Version = int(scds[0])
+	hf.MaxTMDSCharacterRate = int(scds[1]) * 5
+
+	flags := scds[2]
+	hf.SCDCPresent = flags&HF_SCDS_SCDC_PRESENT != 0
+	hf.RRCapable = flags&HF_SCDS_RR_CAPABLE != 0
+	hf.CableStatus = flags&HF_SCDS_CABLE_STATUS != 0
+	hf.CCBPCI = flags&HF_SCDS_CCBPCI != 0
+	hf.LTE340McscScramble = flags&HF_SCDS_LTE_340_SCRAMBLE != 0
+	hf.IndependentView3D = flags&HF_SCDS_3D_INDEPENDENT != 0
+	hf.DualView3D = flags&HF_SCDS_3D_DUAL_VIEW != 0
+	hf.OSDDisparity3D = flags&HF_SCDS_3D_OSD_DISPARITY != 0
+
+	flags = scds[3]
+	hf.MaxFRLRate = int(flags&HF_SCDS_MAX_FRL_MASK) >> 4
+	if rate, ok := hdmiFRLRates[hf.MaxFRLRate]; ok {
+		hf.FRLLanes, hf.FRLRate = rate[0], rate[1]
+	} else if hf.MaxFRLRate != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("HDMI Forum SCDS has a reserved Max_FRL_Rate %d", hf.MaxFRLRate))
+	}
+	hf.UHDVIC = flags&HF_SCDS_UHD_VIC != 0
+	hf.DeepColor48420 = flags&HF_SCDS_DC_48BIT_420 != 0
+	hf.DeepColor36420 = flags&HF_SCDS_DC_36BIT_420 != 0
+	hf.DeepColor30420 = flags&HF_SCDS_DC_30BIT_420 != 0
+
+	offset := HF_SCDS_MIN_LEN
+	if offset >= len(scds) {
+		return &hf
+	}
+	flags = scds[offset]
+	hf.FAPAEndExtended = flags&HF_SCDS_FAPA_END_EXT != 0
+	hf.QMS = flags&HF_SCDS_QMS != 0
+	hf.MDelta = flags&HF_SCDS_M_DELTA != 0
+	hf.CinemaVRR = flags&HF_SCDS_CINEMA_VRR != 0
+	hf.CNMVRR = flags&HF_SCDS_CNMVRR != 0
+	hf.FVA = flags&HF_SCDS_FVA != 0
+	hf.ALLM = flags&HF_SCDS_ALLM != 0
+	hf.FAPAStartLocation = flags&HF_SCDS_FAPA_START != 0
+	offset++
+
+	if offset+2 > len(scds) {
+		return &hf
+	}
+	hf.VRRMin = int(scds[offset] & HF_SCDS_VRR_MIN_MASK)
+	hf.VRRMax = int(scds[offset]&HF_SCDS_VRR_MAX_MSB_MASK)<<2 | int(scds[offset+1])
+	offset += 2
+
+	if offset >= len(scds) {
+		return &hf
+	}
+	flags = scds[offset]
+	hf.DSC12 = flags&HF_SCDS_DSC_1P2 != 0
+	hf.DSCNative420 = flags&HF_SCDS_DSC_NATIVE_420 != 0
+	hf.QMSTFRMax = flags&HF_SCDS_QMS_TFR_MAX != 0
+	hf.QMSTFRMin = flags&HF_SCDS_QMS_TFR_MIN != 0
+	hf.DSCAllBPP = flags&HF_SCDS_DSC_ALL_BPP != 0
+	hf.DSC16BPC = flags&HF_SCDS_DSC_16BPC != 0
+	hf.DSC12BPC = flags&HF_SCDS_DSC_12BPC != 0
+	hf.DSC10BPC = flags&HF_SCDS_DSC_10BPC != 0
+	offset++
+
+	if offset >= len(scds) {
+		return &hf
+	}
+	hf.DSCMaxFRLRate = int(scds[offset]&HF_SCDS_DSC_MAX_FRL_MASK) >> 4
+	if rate, ok := hdmiFRLRates[hf.DSCMaxFRLRate]; ok {
+		hf.DSCFRLLanes, hf.DSCFRLRate = rate[0], rate[1]
+	} else if hf.DSCMaxFRLRate != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("HDMI Forum SCDS has a reserved DSC_Max_FRL_Rate %d", hf.DSCMaxFRLRate))
+	}
+	slices := int(scds[offset] & HF_SCDS_DSC_SLICES_MASK)
+	if slice, ok := hdmiDSCSlices[slices]; ok {
+		hf.DSCMaxSlices, hf.DSCMaxPixelClock = slice[0], slice[1]
+	} else if slices != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("HDMI Forum SCDS has a reserved DSC_MaxSlices %d", slices))
+	}
+	offset++
+
+	if offset >= len(scds) {
+		return &hf
+	}
+	hf.DSCTotalChunkBytes = 1024 * (1 + int(scds[offset]&HF_SCDS_DSC_CHUNK_MASK))
+	return &hf
+}
+
+func parseHDMIForumSCDB(block *CTADataBlock, warnings *[]string) {
+	// The SCDS follows the extended tag and two reserved bytes
+	if len(block.Payload) < 1+HF_SCDB_RESERVED_SIZE {
+		*warnings = append(*warnings, "HDMI Forum SCDB is too short")
+		return
+	}
+	block.HDMIForum = parseHDMIForumSCDS(block.Payload[1+HF_SCDB_RESERVED_SIZE:], warnings)
+}
+
+func parseVendorSpecificDataBlock(block *CTADataBlock, warnings *[]string) {
+	if len(block.Payload) < CTA_OUI_SIZE {
+		*warnings = append(*warnings, "Vendor-specific data block is too short to hold an OUI")
+		return
+	}
+	block.OUI = parseOUI(block.Payload)
+	switch block.OUI {
+	case HDMI_OUI:
+		block.HDMI = parseHDMIVSDB(block.Payload, warnings)
+	case HDMI_FORUM_OUI:
+		block.HDMIForum = parseHDMIForumSCDS(block.Payload[CTA_OUI_SIZE:], warnings)
+	}
+}
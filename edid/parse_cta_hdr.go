@@ -0,0 +1,99 @@
+package edid
+
+import (
+	"fmt"
+	"math"
+)
+
+var hdrEOTFs = []string{
+	"Traditional gamma - SDR luminance range",
+	"Traditional gamma - HDR luminance range",
+	"SMPTE ST 2084",
+	"Hybrid Log-Gamma",
+}
+
+func hdrDynamicMetadataName(metadataType int) string {
+	switch metadataType {
+	case HDR_DYNAMIC_METADATA_TYPE_1:
+		return "SMPTE ST 2094-10 (Dolby)"
+	case HDR_DYNAMIC_METADATA_TYPE_2:
+		return "ETSI TS 103 433 (SL-HDR)"
+	case HDR_DYNAMIC_METADATA_TYPE_3:
+		return "ITU-T H.265 (SMPTE ST 2094-30)"
+	case HDR_DYNAMIC_METADATA_TYPE_4:
+		return "SMPTE ST 2094-40 (HDR10+)"
+	default:
+		return fmt.Sprintf("Unknown type 0x%04x", metadataType)
+	}
+}
+
+// Luminance code values are defined in CTA-861.3 as 50 * 2^(CV/32) cd/m²
+func hdrLuminance(cv byte) float64 {
+	return 50.0 * math.Pow(2, float64(cv)/32.0)
+}
+
+func parseHDRStaticMetadata(payload []byte, warnings *[]string) *HDRStaticMetadata {
+	if len(payload) < HDR_STATIC_METADATA_MIN_LEN {
+		*warnings = append(*warnings, fmt.Sprintf("HDR static metadata data block length %d is too short", len(payload)))
+		return nil
+	}
+	var hdr HDRStaticMetadata
+	for i, eotf := range hdrEOTFs {
+		if payload[0]&(1<<i) != 0 {
+			hdr.EOTFs = append(hdr.EOTFs, eotf)
+		}
+	}
+	for i := 0; i < 8; i++ {
+		if payload[1]&(1<<i) != 0 {
+			hdr.MetadataTypes = append(hdr.MetadataTypes, i+1)
+		}
+	}
+	if len(payload) > 2 {
+		hdr.MaxLuminance = hdrLuminance(payload[2])
+	}
+	if len(payload) > 3 {
+		hdr.MaxFrameAverageLuminance = hdrLuminance(payload[3])
+	}
+	if len(payload) > 4 {
+		// The minimum luminance is relative to the maximum luminance
+		ratio := float64(payload[4]) / 255.0
+		hdr.MinLuminance = hdr.MaxLuminance * ratio * ratio / 100.0
+		hdr.MinLuminancePresent = true
+	}
+	return &hdr
+}
+
+func parseHDRDynamicMetadata(payload []byte, warnings *[]string) []HDRDynamicMetadata {
+	var metadata []HDRDynamicMetadata
+	for offset := 0; offset < len(payload); {
+		length := int(payload[offset])
+		offset++
+		if length < HDR_DYNAMIC_METADATA_TYPE_SIZE || offset+length > len(payload) {
+			*warnings = append(*warnings, fmt.Sprintf("HDR dynamic metadata entry length %d is invalid", length))
+			break
+		}
+		entry := HDRDynamicMetadata{
+			Type:    int(payload[offset+1])<<8 | int(payload[offset]),
+			Version: -1,
+		}
+		entry.Name = hdrDynamicMetadataName(entry.Type)
+		if length > HDR_DYNAMIC_METADATA_TYPE_SIZE {
+			support := payload[offset+HDR_DYNAMIC_METADATA_TYPE_SIZE]
+			entry.Version = int(support & HDR_DYNAMIC_METADATA_VERSION)
+			if entry.Type == HDR_DYNAMIC_METADATA_TYPE_2 {
+				if support&HDR_SL_HDR1 != 0 {
+					entry.Flags = append(entry.Flags, "ETSI TS 103 433-1 (SL-HDR1)")
+				}
+				if support&HDR_SL_HDR2 != 0 {
+					entry.Flags = append(entry.Flags, "ETSI TS 103 433-2 (SL-HDR2)")
+				}
+				if support&HDR_SL_HDR3 != 0 {
+					entry.Flags = append(entry.Flags, "ETSI TS 103 433-3 (SL-HDR3)")
+				}
+			}
+		}
+		metadata = append(metadata, entry)
+		offset += length
+	}
+	return metadata
+}
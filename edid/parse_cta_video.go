@@ -0,0 +1,111 @@
+package edid
+
+import (
+	"fmt"
+)
+
+var ctaColorimetries = []string{
+	"xvYCC601",
+	"xvYCC709",
+	"sYCC601",
+	"opYCC601",
+	"opRGB",
+	"BT2020cYCC",
+	"BT2020YCC",
+	"BT2020RGB",
+	"", "", "", "", "", // gamut metadata profiles and reserved
+	"ST2113RGB",
+	"ICtCp",
+	"DCI-P3",
+}
+
+func parseColorimetryDataBlock(payload []byte, warnings *[]string) *Colorimetry {
+	if len(payload) < CTA_COLORIMETRY_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("Colorimetry data block length %d is too short", len(payload)))
+		return nil
+	}
+	var colorimetry Colorimetry
+	flags := uint16(payload[1])<<8 | uint16(payload[0])
+	for i, name := range ctaColorimetries {
+		if name != "" && flags&(1<<i) != 0 {
+			colorimetry.Colorimetries = append(colorimetry.Colorimetries, name)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		if payload[1]&CTA_COLORIMETRY_MD_MASK&(1<<i) != 0 {
+			colorimetry.MetadataProfiles = append(colorimetry.MetadataProfiles, i)
+		}
+	}
+	return &colorimetry
+}
+
+func scanBehavior(behavior byte, unsupported string) string {
+	switch behavior {
+	case 0x00:
+		return unsupported
+	case 0x01:
+		return "Always overscanned"
+	case 0x02:
+		return "Always underscanned"
+	default:
+		return "Supports both over- and underscan"
+	}
+}
+
+func parseVideoCapabilityDataBlock(payload []byte, warnings *[]string) *VideoCapability {
+	if len(payload) < CTA_VIDEO_CAP_SIZE {
+		*warnings = append(*warnings, "Video capability data block is too short")
+		return nil
+	}
+	flags := payload[0]
+	return &VideoCapability{
+		QuantizationYCC: flags&CTA_VIDEO_CAP_QY != 0,
+		QuantizationRGB: flags&CTA_VIDEO_CAP_QS != 0,
+		PreferredScan:   scanBehavior((flags&CTA_VIDEO_CAP_S_PT_MASK)>>4, "No data"),
+		ITScan:          scanBehavior((flags&CTA_VIDEO_CAP_S_IT_MASK)>>2, "IT video formats not supported"),
+		CEScan:          scanBehavior(flags&CTA_VIDEO_CAP_S_CE_MASK, "CE video formats not supported"),
+	}
+}
+
+func parseYCbCr420CapabilityMap(payload []byte) []int {
+	var indices []int
+	for i, bitmap := range payload {
+		for bit := 0; bit < 8; bit++ {
+			if bitmap&(1<<bit) != 0 {
+				indices = append(indices, i*8+bit)
+			}
+		}
+	}
+	return indices
+}
+
+// The 4:2:0 capability map indexes the SVDs of all video data blocks in order of appearance
+func applyYCbCr420CapabilityMap(cta *CTAExtension, warnings *[]string) {
+	var svds []*ShortVideoDescriptor
+	for i := range cta.DataBlocks {
+		if cta.DataBlocks[i].Tag != CTA_EXT_TAG_VIDEO_DATA_BLOCK {
+			continue
+		}
+		for j := range cta.DataBlocks[i].VideoDescriptors {
+			svds = append(svds, &cta.DataBlocks[i].VideoDescriptors[j])
+		}
+	}
+	for _, block := range cta.DataBlocks {
+		if block.Tag != CTA_EXT_TAG_USE_EXTENDED_TAG || block.ExtendedTag != CTA_EXTENDED_TAG_YCBCR420_CAPABILITY_MAP_DATA_BLOCK {
+			continue
+		}
+		if block.YCbCr420MapAll {
+			for _, svd := range svds {
+				svd.YCbCr420 = true
+			}
+			continue
+		}
+		for _, index := range block.YCbCr420Map {
+			if index >= len(svds) {
+				*warnings = append(*warnings, fmt.Sprintf("YCbCr 4:2:0 capability map references SVD %d, only %d SVDs present", index, len(svds)))
+				continue
+			}
+			svds[index].YCbCr420 = true
+		}
+	}
+}
@@ -0,0 +1,563 @@
+package edid
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+func parseTiledDisplayTopology(dd []byte) TiledDisplayTopology {
+	var topology TiledDisplayTopology
+	topology.Revision = dd[1]
+
+	// display capabilities
+	caps := dd[3]
+	topology.OneTileBehavior = caps & TILE_ONE_TILE_BEHAVIOR
+	topology.NTileBehavior = (caps & TILE_N_TILE_BEHAVIOR) >> 3
+	topology.BezelInformation = caps&TILE_BEZEL_DESCRIPTOR != 0x00
+	topology.SingleEnclosure = caps&TILE_PHYSICAL_ENCLOSURE != 0x00
+
+	// Tiled display topology, the counts are stored minus one
+	nrTilesLSB := dd[4]
+	locationTilesLSB := dd[5]
+	tilesMSB := dd[6]
+
+	topology.HorizontalTiles = (int(tilesMSB&TILE_H_TILES_MSB)>>6<<4 | int(nrTilesLSB>>4)) + 1
+	topology.VerticalTiles = (int(tilesMSB&TILE_V_TILES_MSB)>>4<<4 | int(nrTilesLSB&0x0f)) + 1
+
+	// tiled display location
+	topology.HorizontalLocation = int(tilesMSB&TILE_H_LOCATION_MSB)>>2<<4 | int(locationTilesLSB>>4)
+	topology.VerticalLocation = int(tilesMSB&TILE_V_LOCATION_MSB)<<4 | int(locationTilesLSB&0x0f)
+
+	// tile size
+	horizontalSizeLSB := dd[7]
+	horizontalSizeMSB := dd[8]
+	topology.TileWidth = ((int(horizontalSizeMSB) << 8) | int(horizontalSizeLSB)) + 1
+
+	verticalSizeLSB := dd[9]
+	verticalSizeMSB := dd[10]
+	topology.TileHeight = ((int(verticalSizeMSB) << 8) | int(verticalSizeLSB)) + 1
+
+	// Tile pixel multiplier and bezel sizes, the bezels are stored in
+	// tenths of the pixel multiplier
+	topology.PixelMultiplier = int(dd[11])
+	topology.TopBezel = float64(topology.PixelMultiplier*int(dd[12])) / 10.0
+	topology.BottomBezel = float64(topology.PixelMultiplier*int(dd[13])) / 10.0
+	topology.RightBezel = float64(topology.PixelMultiplier*int(dd[14])) / 10.0
+	topology.LeftBezel = float64(topology.PixelMultiplier*int(dd[15])) / 10.0
+
+	// Tiled display topology ID, DisplayID 2.x uses an OUI instead of a PNP ID
+	if dd[0] == CTA_BLOCK_TILED_DISPLAY_LEGACY {
+		topology.VendorID = string(dd[16:19])
+	} else {
+		topology.VendorID = fmt.Sprintf("%02X-%02X-%02X", dd[16], dd[17], dd[18])
+	}
+	topology.ProductCode = binary.LittleEndian.Uint16(dd[19:21])
+	topology.SerialNumber = binary.LittleEndian.Uint32(dd[21:25])
+
+	return topology
+}
+
+func displayIDAspectRatio(ar byte) string {
+	switch ar {
+	case 0x00:
+		return "1:1"
+	case 0x01:
+		return "5:4"
+	case 0x02:
+		return "4:3"
+	case 0x03:
+		return "15:9"
+	case 0x04:
+		return "16:9"
+	case 0x05:
+		return "16:10"
+	case 0x06:
+		return "64:27"
+	case 0x07:
+		return "256:135"
+	case 0x08:
+		return "Undefined"
+	default:
+		return fmt.Sprintf("Reserved (%d)", ar)
+	}
+}
+
+func displayIDStereo(stereo byte) string {
+	switch stereo {
+	case 0x00:
+		return "Mono"
+	case 0x01:
+		return "Stereo"
+	case 0x02:
+		return "Mono or stereo, user selectable"
+	default:
+		return "Reserved"
+	}
+}
+
+// parseDisplayIDTimingOptions decodes the options byte shared by the
+// detailed and enumerated DisplayID timing descriptors
+func parseDisplayIDTimingOptions(timing *DisplayIDTiming, options byte) {
+	timing.Options = options
+	timing.Preferred = options&DISPLAYID_TIMING_PREFERRED != 0
+	timing.Stereo = displayIDStereo((options & DISPLAYID_TIMING_STEREO_MASK) >> 5)
+	timing.Interlaced = options&DISPLAYID_TIMING_INTERLACED != 0
+	timing.AspectRatio = displayIDAspectRatio(options & DISPLAYID_TIMING_ASPECT_MASK)
+}
+
+// parseDisplayIDDetailedTiming decodes a 20 byte detailed timing descriptor,
+// the pixel clock is stored minus one in units of pixelClockStep MHz
+func parseDisplayIDDetailedTiming(d []byte, pixelClockStep float64) DisplayIDTiming {
+	var vtd VTBDescriptor
+	var timing DisplayIDTiming
+	// Video timing block descriptor
+	vtd.pixelClockLBits = d[0] // Pixel clock low bits
+	vtd.pixelClockMBits = d[1] // Pixel clock middle bits
+	vtd.pixelClockHBits = d[2] // Pixel clock high bits
+	timing.PixelClock = float64((int(vtd.pixelClockHBits)<<16)|(int(vtd.pixelClockMBits)<<8)|int(vtd.pixelClockLBits)+1) * pixelClockStep
+
+	vtd.timingOptions = d[3]
+	parseDisplayIDTimingOptions(&timing, vtd.timingOptions)
+
+	vtd.hActiveLSB = d[4] // Horizontal active low bits
+	vtd.hActiveMSB = d[5] // Horizontal active high bits
+	timing.HorizontalActive = (int(vtd.hActiveMSB)<<8 | int(vtd.hActiveLSB)) + 1
+
+	vtd.hBlankingLSB = d[6] // Horizontal blanking low bits
+	vtd.hBlankingMSB = d[7] // Horizontal blanking high bits
+	timing.HorizontalBlanking = (int(vtd.hBlankingMSB)<<8 | int(vtd.hBlankingLSB)) + 1
+
+	vtd.hFrontPorchLSB = d[8]        // Horizontal front porch low bits
+	vtd.hFrontPorchMSB = d[9] & 0x7F // Horizontal front porch high bits
+	timing.HorizontalFrontPorch = (int(vtd.hFrontPorchMSB)<<8 | int(vtd.hFrontPorchLSB)) + 1
+
+	timing.HorizontalSyncPositive = d[9]&0x80>>7 == 1 // Horizontal sync polarity
+	vtd.hSyncWidthLSB = d[10]                         // Horizontal sync width low bits
+	vtd.hSyncWidthMSB = d[11]                         // Horizontal sync width high bits
+	timing.HorizontalSyncWidth = (int(vtd.hSyncWidthMSB)<<8 | int(vtd.hSyncWidthLSB)) + 1
+
+	vtd.vActiveLSB = d[12] // Vertical active low bits
+	vtd.vActiveMSB = d[13] // Vertical active high bits
+	timing.VerticalActive = (int(vtd.vActiveMSB)<<8 | int(vtd.vActiveLSB)) + 1
+
+	vtd.vBlankingLSB = d[14] // Vertical blanking low bits
+	vtd.vBlankingMSB = d[15] // Vertical blanking high bits
+	timing.VerticalBlanking = (int(vtd.vBlankingMSB)<<8 | int(vtd.vBlankingLSB)) + 1
+
+	vtd.vFrontPorchLSB = d[16]        // Vertical front porch low bits
+	vtd.vFrontPorchMSB = d[17] & 0x7F // Vertical front porch high bits
+	timing.VerticalFrontPorch = (int(vtd.vFrontPorchMSB)<<8 | int(vtd.vFrontPorchLSB)) + 1
+
+	timing.VerticalSyncPositive = d[17]&0x80>>7 == 1 // Vertical sync polarity
+	vtd.vSyncWidthLSB = d[18]                        // Vertical sync width low bits
+	vtd.vSyncWidthMSB = d[19]                        // Vertical sync width high bits
+	timing.VerticalSyncWidth = (int(vtd.vSyncWidthMSB)<<8 | int(vtd.vSyncWidthLSB)) + 1
+
+	timing.RefreshRate = timing.Timing.refreshRate()
+	return timing
+}
+
+func parseVTBType1(vtb []byte) []DisplayIDTiming {
+	numberOfPayloadBytes := vtb[2]
+	timings := make([]DisplayIDTiming, 0)
+
+	offset := 3
+	for i := 0; i < int(numberOfPayloadBytes/CTA_VTB_TYPE_1_DESCRIPTOR_SIZE); i++ {
+		// Type I pixel clocks are stored in 10 kHz steps
+		timings = append(timings, parseDisplayIDDetailedTiming(vtb[offset:offset+CTA_VTB_TYPE_1_DESCRIPTOR_SIZE], 0.01))
+		offset += CTA_VTB_TYPE_1_DESCRIPTOR_SIZE
+	}
+
+	return timings
+}
+
+func parseVTBType7(vtb []byte, warnings *[]string) []DisplayIDTiming {
+	numberOfPayloadBytes := int(vtb[2])
+	timings := make([]DisplayIDTiming, 0)
+
+	// Revision bits 6:4 announce additional bytes per descriptor
+	descriptorSize := CTA_VTB_TYPE_7_DESCRIPTOR_SIZE + int(vtb[1]&DISPLAYID_TYPE_7_EXTRA_MASK)>>4
+	if numberOfPayloadBytes%descriptorSize != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("VTB type 7 length %d is not a multiple of %d", numberOfPayloadBytes, descriptorSize))
+	}
+
+	offset := 3
+	for i := 0; i < numberOfPayloadBytes/descriptorSize; i++ {
+		// Type VII pixel clocks are stored in 1 kHz steps
+		timings = append(timings, parseDisplayIDDetailedTiming(vtb[offset:offset+CTA_VTB_TYPE_7_DESCRIPTOR_SIZE], 0.001))
+		offset += descriptorSize
+	}
+
+	return timings
+}
+
+// displayIDTimingCode resolves a 1-byte DMT, CTA-861 or HDMI timing code
+func displayIDTimingCode(codeType int, code int) (DisplayIDTiming, bool) {
+	var timing DisplayIDTiming
+	switch codeType {
+	case DISPLAYID_CODE_DMT:
+		timing.Code = fmt.Sprintf("DMT 0x%02x", code)
+		if dmt, found := dmtByID(code); found {
+			timing.Timing = dmt.timing()
+			return timing, true
+		}
+	case DISPLAYID_CODE_CTA:
+		timing.Code = fmt.Sprintf("VIC %d", code)
+		if vic, found := ctaVICTable[code]; found {
+			timing.Timing = vic.timing()
+			timing.AspectRatio = vic.aspectRatio
+			return timing, true
+		}
+	case DISPLAYID_CODE_HDMI:
+		timing.Code = fmt.Sprintf("HDMI VIC %d", code)
+		if vic, found := hdmiVICs[code]; found {
+			timing.Timing = ctaVICTable[vic].timing()
+			return timing, true
+		}
+	default:
+		timing.Code = fmt.Sprintf("Reserved code type %d: 0x%02x", codeType, code)
+	}
+	return timing, false
+}
+
+func parseVTBType8(vtb []byte, warnings *[]string) []DisplayIDTiming {
+	numberOfPayloadBytes := int(vtb[2])
+	timings := make([]DisplayIDTiming, 0)
+
+	codeType := int(vtb[1]&DISPLAYID_TYPE_8_CODE_MASK) >> 6
+	codeSize := 1
+	if vtb[1]&DISPLAYID_TYPE_8_2BYTE_CODES != 0 {
+		codeSize = 2
+	}
+
+	offset := 3
+	for i := 0; i < numberOfPayloadBytes/codeSize; i++ {
+		var timing DisplayIDTiming
+		ok := false
+		if codeSize == 2 {
+			// 2 byte codes use the standard timing format
+			var st [STANDARD_TIMINGS_SIZE]byte
+			copy(st[:], vtb[offset:offset+2])
+			std := parseStandardTiming(st)
+			timing.Code = fmt.Sprintf("STD 0x%02x%02x", st[0], st[1])
+			if !std.Unused {
+				if dmt, found := dmtByMode(std.HorizontalActive, std.VerticalActive, std.RefreshRate, false); found {
+					timing.Timing = dmt.timing()
+				} else {
+					timing.Timing = cvtTiming(std.HorizontalActive, std.VerticalActive, float64(std.RefreshRate), CVT_STANDARD_BLANKING, false)
+				}
+				ok = true
+			}
+		} else {
+			timing, ok = displayIDTimingCode(codeType, int(vtb[offset]))
+		}
+		if !ok {
+			*warnings = append(*warnings, fmt.Sprintf("VTB type 8 timing code %s is unknown", timing.Code))
+		} else {
+			timings = append(timings, timing)
+		}
+		offset += codeSize
+	}
+
+	return timings
+}
+
+func displayIDFormulaName(formula int) string {
+	switch formula {
+	case CVT_REDUCED_BLANKING:
+		return "CVT-RB"
+	case CVT_REDUCED_BLANKING2:
+		return "CVT-RB2"
+	case CVT_REDUCED_BLANKING3:
+		return "CVT-RB3"
+	default:
+		return "CVT"
+	}
+}
+
+// parseDisplayIDFormulaTiming decodes a type 9 or type 10 descriptor and
+// expands it into a full timing with the CVT formula
+func parseDisplayIDFormulaTiming(d []byte, formulas []int, refreshRate int, warnings *[]string) (DisplayIDTiming, bool) {
+	var timing DisplayIDTiming
+	options := d[0]
+	timing.Options = options
+	timing.Stereo = displayIDStereo((options & DISPLAYID_TIMING_STEREO_MASK) >> 5)
+	hActive := (int(d[2])<<8 | int(d[1])) + 1
+	vActive := (int(d[4])<<8 | int(d[3])) + 1
+
+	formula := int(options & DISPLAYID_FORMULA_MASK)
+	if formula >= len(formulas) {
+		*warnings = append(*warnings, fmt.Sprintf("DisplayID timing formula %d is reserved", formula))
+		return timing, false
+	}
+	blanking := formulas[formula]
+	timing.Formula = displayIDFormulaName(blanking)
+
+	wideHBlank := false
+	if options&DISPLAYID_FORMULA_FLAG != 0 {
+		switch blanking {
+		case CVT_REDUCED_BLANKING2:
+			timing.Flags = append(timing.Flags, "Refresh rate * (1000/1001) supported")
+		case CVT_REDUCED_BLANKING3:
+			wideHBlank = true
+			timing.Flags = append(timing.Flags, "Horizontal blanking of 160 pixels")
+		default:
+			*warnings = append(*warnings, fmt.Sprintf("DisplayID %s timing %dx%d sets the formula flag, which must be 0", timing.Formula, hActive, vActive))
+		}
+	}
+	timing.Timing = cvtTiming(hActive, vActive, float64(refreshRate), blanking, wideHBlank)
+	return timing, true
+}
+
+func parseVTBType9(vtb []byte, warnings *[]string) []DisplayIDTiming {
+	numberOfPayloadBytes := int(vtb[2])
+	timings := make([]DisplayIDTiming, 0)
+
+	offset := 3
+	for i := 0; i < numberOfPayloadBytes/CTA_VTB_TYPE_9_DESCRIPTOR_SIZE; i++ {
+		d := vtb[offset : offset+CTA_VTB_TYPE_9_DESCRIPTOR_SIZE]
+		formulas := []int{CVT_STANDARD_BLANKING, CVT_REDUCED_BLANKING, CVT_REDUCED_BLANKING2}
+		if timing, ok := parseDisplayIDFormulaTiming(d, formulas, int(d[5])+1, warnings); ok {
+			timings = append(timings, timing)
+		}
+		offset += CTA_VTB_TYPE_9_DESCRIPTOR_SIZE
+	}
+
+	return timings
+}
+
+func parseVTBType10(vtb []byte, warnings *[]string) []DisplayIDTiming {
+	numberOfPayloadBytes := int(vtb[2])
+	timings := make([]DisplayIDTiming, 0)
+
+	// Revision bits 6:4 announce additional bytes per descriptor
+	descriptorSize := CTA_VTB_TYPE_10_MIN_SIZE + int(vtb[1]&DISPLAYID_TYPE_10_EXTRA_MASK)>>4
+	if numberOfPayloadBytes%descriptorSize != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("VTB type 10 length %d is not a multiple of %d", numberOfPayloadBytes, descriptorSize))
+	}
+
+	offset := 3
+	for i := 0; i < numberOfPayloadBytes/descriptorSize; i++ {
+		d := vtb[offset : offset+descriptorSize]
+		refreshRate := int(d[5]) + 1
+		if descriptorSize > CTA_VTB_TYPE_10_MIN_SIZE {
+			refreshRate += int(d[6]&0x03) << 8
+		}
+		formulas := []int{CVT_STANDARD_BLANKING, CVT_REDUCED_BLANKING, CVT_REDUCED_BLANKING2, CVT_REDUCED_BLANKING3}
+		if timing, ok := parseDisplayIDFormulaTiming(d, formulas, refreshRate, warnings); ok {
+			timings = append(timings, timing)
+		}
+		offset += descriptorSize
+	}
+
+	return timings
+}
+
+func displayIDProductTypeName(revision byte, productType byte) string {
+	if revision < DISPLAYID_VERSION_2_0 {
+		switch productType {
+		case 0x00:
+			return "Extension section"
+		case 0x01:
+			return "Test structure"
+		case 0x02:
+			return "Display panel or other transducer"
+		case 0x03:
+			return "Standalone display device"
+		case 0x04:
+			return "Television receiver"
+		case 0x05:
+			return "Repeater/translator"
+		case 0x06:
+			return "Direct drive monitor"
+		}
+	} else {
+		switch productType {
+		case 0x00:
+			return "Same primary use case as the base section"
+		case 0x01:
+			return "Test structure"
+		case 0x02:
+			return "Generic display"
+		case 0x03:
+			return "Television"
+		case 0x04:
+			return "Desktop productivity display"
+		case 0x05:
+			return "Desktop gaming display"
+		case 0x06:
+			return "Presentation display"
+		case 0x07:
+			return "Head-mounted virtual reality display"
+		case 0x08:
+			return "Head-mounted augmented reality display"
+		}
+	}
+	return fmt.Sprintf("Reserved (0x%02x)", productType)
+}
+
+func isPadding(data []byte) bool {
+	for _, b := range data {
+		if b != 0x00 {
+			return false
+		}
+	}
+	return true
+}
+
+func parseDisplayIDBlock(data []byte, warnings *[]string) DisplayIDBlock {
+	block := DisplayIDBlock{
+		Tag:      data[0],
+		Revision: data[1],
+		Length:   int(data[2]),
+	}
+	switch block.Tag {
+	case CTA_BLOCK_PRODUCT_ID, CTA_BLOCK_PRODUCT_ID_LEGACY:
+		block.ProductID = parseProductIdentification(data, block.Tag == CTA_BLOCK_PRODUCT_ID_LEGACY, warnings)
+	case CTA_BLOCK_DISPLAY_PARAMS, CTA_BLOCK_DISPLAY_PARAMS_LEGACY:
+		block.Parameters = parseDisplayParameters(data, block.Tag == CTA_BLOCK_DISPLAY_PARAMS_LEGACY, warnings)
+	case CTA_BLOCK_INTERFACE_FEATURES:
+		block.Interface = parseInterfaceFeatures(data, warnings)
+	case CTA_BLOCK_STEREO_INTERFACE:
+		block.Stereo = parseStereoInterface(data, warnings)
+	case CTA_BLOCK_CONTAINER_ID:
+		block.ContainerID = parseContainerID(data, warnings)
+	case CTA_BLOCK_ADAPTIVE_SYNC:
+		block.AdaptiveSync = parseAdaptiveSync(data, warnings)
+	case CTA_BLOCK_VENDOR_SPECIFIC, CTA_BLOCK_VENDOR_SPECIFIC_LEGACY:
+		parseDisplayIDVendorSpecific(&block, data, warnings)
+	case CTA_BLOCK_TILED_DISPLAY, CTA_BLOCK_TILED_DISPLAY_LEGACY:
+		if block.Tag == CTA_BLOCK_TILED_DISPLAY_LEGACY {
+			*warnings = append(*warnings, "Tiled display block (0x12) is deprecated and superseded by Tiled display block (0x28)")
+		}
+		if len(data) < CTA_BLOCK_TILED_SIZE {
+			*warnings = append(*warnings, fmt.Sprintf("Tiled display block length %d is too short", block.Length))
+			break
+		}
+		topology := parseTiledDisplayTopology(data)
+		if topology.HorizontalLocation >= topology.HorizontalTiles || topology.VerticalLocation >= topology.VerticalTiles {
+			*warnings = append(*warnings, fmt.Sprintf("Tile location %d, %d is outside the %d x %d tiled display", topology.HorizontalLocation, topology.VerticalLocation, topology.HorizontalTiles, topology.VerticalTiles))
+		}
+		block.TiledTopology = &topology
+	case CTA_BLOCK_VTB_TYPE_1:
+		*warnings = append(*warnings, "VTB Type 1 (0x03) is deprecated and superseded by VTB Type 7 (0x22)")
+		block.Timings = parseVTBType1(data)
+	case CTA_BLOCK_VTB_TYPE_2:
+		block.Timings = parseVTBType2(data)
+	case CTA_BLOCK_VTB_TYPE_3:
+		block.Timings = parseVTBType3(data, warnings)
+	case CTA_BLOCK_VTB_TYPE_4:
+		block.Timings = parseVTBType4(data, warnings)
+	case CTA_BLOCK_VESA_TIMINGS:
+		block.Timings = parseTimingBitmap(data, DISPLAYID_CODE_DMT, DISPLAYID_VESA_TIMINGS_SIZE, warnings)
+	case CTA_BLOCK_CTA_TIMINGS:
+		block.Timings = parseTimingBitmap(data, DISPLAYID_CODE_CTA, DISPLAYID_CTA_TIMINGS_SIZE, warnings)
+	case CTA_BLOCK_COLOR_CHARACTERISTICS:
+		block.ColorCharacteristics = parseColorCharacteristics(data, warnings)
+	case CTA_BLOCK_RANGE_LIMITS:
+		block.RangeLimits = parseRangeLimits(data, warnings)
+	case CTA_BLOCK_SERIAL_NUMBER, CTA_BLOCK_ASCII_STRING:
+		block.String = parseDisplayIDString(data)
+	case CTA_BLOCK_POWER_SEQUENCING:
+		block.PowerSequencing = parsePowerSequencing(data, warnings)
+	case CTA_BLOCK_TRANSFER_CHARACTERISTICS:
+		block.Transfer = parseTransferCharacteristics(data, warnings)
+	case CTA_BLOCK_DISPLAY_INTERFACE:
+		block.LegacyInterface = parseLegacyInterface(data, warnings)
+	case CTA_BLOCK_VTB_TYPE_7:
+		block.Timings = parseVTBType7(data, warnings)
+	case CTA_BLOCK_VTB_TYPE_8:
+		block.Timings = parseVTBType8(data, warnings)
+	case CTA_BLOCK_VTB_TYPE_9:
+		block.Timings = parseVTBType9(data, warnings)
+	case CTA_BLOCK_VTB_TYPE_10:
+		block.Timings = parseVTBType10(data, warnings)
+	default:
+		*warnings = append(*warnings, fmt.Sprintf("Unknown DisplayID block type 0x%02x", block.Tag))
+	}
+	return block
+}
+
+// decodeDisplayIDSection decodes the section at the start of data and
+// returns it together with the number of bytes it occupies
+func decodeDisplayIDSection(data []byte, warnings *[]string) (DisplayIDSection, int) {
+	var section DisplayIDSection
+	if len(data) < DISPLAYID_SECTION_HEADER_SIZE+CHECKSUM_SIZE {
+		*warnings = append(*warnings, "DisplayID section is too short to hold a section header")
+		return section, len(data)
+	}
+
+	section.Revision = data[0]
+	if section.Revision != DISPLAYID_VERSION_1_3 && section.Revision != DISPLAYID_VERSION_2_0 && section.Revision != DISPLAYID_VERSION_2_1 {
+		*warnings = append(*warnings, fmt.Sprintf("DisplayID revision 0x%02x is invalid or unsupported", section.Revision))
+	}
+	section.Length = int(data[1])
+	section.ProductType = data[2]
+	section.ProductTypeName = displayIDProductTypeName(section.Revision, section.ProductType)
+	section.ExtensionCount = int(data[3])
+
+	size := DISPLAYID_SECTION_HEADER_SIZE + section.Length + CHECKSUM_SIZE
+	if section.Length > DISPLAYID_MAX_SECTION_PAYLOAD || size > len(data) {
+		*warnings = append(*warnings, fmt.Sprintf("DisplayID section length %d exceeds the available %d bytes", section.Length, len(data)-DISPLAYID_SECTION_HEADER_SIZE-CHECKSUM_SIZE))
+		size = len(data)
+		section.Length = size - DISPLAYID_SECTION_HEADER_SIZE - CHECKSUM_SIZE
+	}
+
+	payload := data[DISPLAYID_SECTION_HEADER_SIZE : DISPLAYID_SECTION_HEADER_SIZE+section.Length]
+	for offset := 0; offset < len(payload); {
+		// Trailing zero bytes within the section are padding
+		if isPadding(payload[offset:]) {
+			break
+		}
+		if offset+DISPLAYID_BLOCK_HEADER_SIZE > len(payload) {
+			*warnings = append(*warnings, fmt.Sprintf("DisplayID block header at offset 0x%02x exceeds the section", offset))
+			break
+		}
+		end := offset + DISPLAYID_BLOCK_HEADER_SIZE + int(payload[offset+2])
+		if end > len(payload) {
+			*warnings = append(*warnings, fmt.Sprintf("DisplayID block 0x%02x at offset 0x%02x exceeds the section", payload[offset], offset))
+			break
+		}
+		section.Blocks = append(section.Blocks, parseDisplayIDBlock(payload[offset:end], warnings))
+		offset = end
+	}
+
+	section.Checksum = data[size-1]
+	section.ChecksumValid = generateChecksum(data[:size-1]) == section.Checksum
+	return section, size
+}
+
+func decodeDisplayIDExtension(extBlock *ExtensionBlock, warnings *[]string) (*DisplayID, error) {
+	var displayID DisplayID
+
+	// The sections follow the extension tag, the last byte is the extension block checksum
+	data := extBlock.data[CTA_EXT_TAG_SIZE : EXTENSION_SIZE-CHECKSUM_SIZE]
+	for offset := 0; offset < len(data) && !isPadding(data[offset:]); {
+		section, size := decodeDisplayIDSection(data[offset:], warnings)
+		displayID.Sections = append(displayID.Sections, section)
+		offset += size
+	}
+	if len(displayID.Sections) == 0 {
+		*warnings = append(*warnings, "DisplayID extension does not contain a section")
+	}
+	return &displayID, nil
+}
+
+func (displayID DisplayIDStructure) Decode() (DecodedDisplayID, error) {
+	var decoded DecodedDisplayID
+	decoded.Warnings = make([]string, 0)
+	for _, data := range displayID.sections {
+		section, _ := decodeDisplayIDSection(data, &decoded.Warnings)
+		decoded.Sections = append(decoded.Sections, section)
+	}
+	return decoded, nil
+}
+
+func (displayID DisplayIDStructure) Parse() ([]string, error) {
+	decoded, err := displayID.Decode()
+	if err != nil {
+		return decoded.Warnings, err
+	}
+	decoded.Print()
+	return decoded.Warnings, nil
+}
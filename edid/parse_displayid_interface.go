@@ -0,0 +1,259 @@
+package edid
+
+import "fmt"
+
+var displayIDRGBDepths = []int{6, 8, 10, 12, 14, 16}
+
+var displayIDYCbCrDepths = []int{8, 10, 12, 14, 16}
+
+var displayIDColorSpaces = []string{
+	"Not defined",
+	"sRGB",
+	"BT.601",
+	"BT.709",
+	"Adobe RGB",
+	"DCI-P3",
+	"BT.2020",
+	"Custom",
+}
+
+var displayIDEOTFs = []string{
+	"Not defined",
+	"sRGB",
+	"BT.601",
+	"BT.1886",
+	"Adobe RGB",
+	"DCI-P3",
+	"BT.2020",
+	"Gamma function",
+	"SMPTE ST 2084",
+	"Hybrid Log",
+	"Custom",
+}
+
+var displayIDColorSpaceCombinations = []string{
+	"sRGB",
+	"BT.601",
+	"BT.709/BT.1886",
+	"Adobe RGB",
+	"DCI-P3",
+	"BT.2020",
+	"BT.2020/SMPTE ST 2084",
+}
+
+func displayIDBitDepthList(bitmap byte, depths []int) []int {
+	supported := make([]int, 0)
+	for i, depth := range depths {
+		if bitmap&(1<<i) != 0 {
+			supported = append(supported, depth)
+		}
+	}
+	return supported
+}
+
+func parseInterfaceFeatures(data []byte, warnings *[]string) *DisplayIDInterfaceFeatures {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_INTERFACE_FEATURES_MIN_LEN {
+		*warnings = append(*warnings, fmt.Sprintf("Display interface features block length %d is too short", len(payload)))
+		return nil
+	}
+	var features DisplayIDInterfaceFeatures
+	features.RGBDepths = displayIDBitDepthList(payload[0], displayIDRGBDepths)
+	features.YCbCr444Depths = displayIDBitDepthList(payload[1], displayIDRGBDepths)
+	features.YCbCr422Depths = displayIDBitDepthList(payload[2], displayIDYCbCrDepths)
+	features.YCbCr420Depths = displayIDBitDepthList(payload[3], displayIDYCbCrDepths)
+	features.MinPixelRate420 = float64(payload[4]) * DISPLAYID_INTERFACE_MIN_420_RATE
+
+	if payload[5]&0x80 != 0 {
+		features.AudioSampleRates = append(features.AudioSampleRates, "32 kHz")
+	}
+	if payload[5]&0x40 != 0 {
+		features.AudioSampleRates = append(features.AudioSampleRates, "44.1 kHz")
+	}
+	if payload[5]&0x20 != 0 {
+		features.AudioSampleRates = append(features.AudioSampleRates, "48 kHz")
+	}
+
+	for i, combination := range displayIDColorSpaceCombinations {
+		if payload[6]&(1<<i) != 0 {
+			features.ColorSpaces = append(features.ColorSpaces, combination)
+		}
+	}
+
+	// Byte 7 is reserved, byte 8 holds the number of additional combinations
+	// that follow the fixed part of the payload
+	count := int(payload[DISPLAYID_INTERFACE_FEATURES_MIN_LEN-1] & DISPLAYID_INTERFACE_COMBINATIONS)
+	if DISPLAYID_INTERFACE_FEATURES_MIN_LEN+count > len(payload) {
+		*warnings = append(*warnings, "Display interface features additional combinations exceed the block")
+		count = len(payload) - DISPLAYID_INTERFACE_FEATURES_MIN_LEN
+	}
+	for _, combination := range payload[DISPLAYID_INTERFACE_FEATURES_MIN_LEN : DISPLAYID_INTERFACE_FEATURES_MIN_LEN+count] {
+		colorSpace := "Reserved"
+		if int(combination&0x0F) < len(displayIDColorSpaces) {
+			colorSpace = displayIDColorSpaces[combination&0x0F]
+		}
+		eotf := "Reserved"
+		if int(combination>>4) < len(displayIDEOTFs) {
+			eotf = displayIDEOTFs[combination>>4]
+		}
+		features.ColorSpaces = append(features.ColorSpaces, fmt.Sprintf("%s/%s", colorSpace, eotf))
+	}
+	return &features
+}
+
+func eyeView(right bool) string {
+	if right {
+		return "Right"
+	}
+	return "Left"
+}
+
+func parseStereoInterface(data []byte, warnings *[]string) *DisplayIDStereoInterface {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_STEREO_MIN_LEN || payload[0] == 0 || 1+int(payload[0]) > len(payload) {
+		*warnings = append(*warnings, fmt.Sprintf("Stereo display interface block length %d is too short", len(payload)))
+		return nil
+	}
+	var stereo DisplayIDStereoInterface
+	switch (data[1] & DISPLAYID_STEREO_TIMING_SUPPORT) >> 6 {
+	case 0x00:
+		stereo.TimingSupport = "Timings that explicitly report 3D capability"
+	case 0x01:
+		stereo.TimingSupport = "Timings that explicitly report 3D capability and the timing codes listed here"
+	case 0x02:
+		stereo.TimingSupport = "All listed timings"
+	case 0x03:
+		stereo.TimingSupport = "Only the timing codes listed here"
+	}
+
+	// The method parameter length includes the method code
+	params := payload[2 : 1+int(payload[0])]
+	stereo.MethodCode = payload[1]
+	param := func(i int) byte {
+		if i < len(params) {
+			return params[i]
+		}
+		*warnings = append(*warnings, fmt.Sprintf("Stereo display interface method 0x%02x is missing parameter %d", stereo.MethodCode, i))
+		return 0
+	}
+	switch stereo.MethodCode {
+	case 0x00:
+		stereo.Method = "Field sequential"
+		polarity := "1/0"
+		if param(0)&0x01 != 0 {
+			polarity = "0/1"
+		}
+		stereo.Details = append(stereo.Details, fmt.Sprintf("L/R polarity: %s", polarity))
+	case 0x01:
+		stereo.Method = "Side-by-side"
+		stereo.Details = append(stereo.Details, fmt.Sprintf("Left half: %s eye view", eyeView(param(0)&0x01 != 0)))
+	case 0x02:
+		stereo.Method = "Pixel interleaved"
+		for i := 0; i < 8; i++ {
+			stereo.Details = append(stereo.Details, fmt.Sprintf("Pattern row %d: %08b", i, param(i)))
+		}
+	case 0x03:
+		stereo.Method = "Dual interface, left and right separate"
+		p := param(0)
+		stereo.Details = append(stereo.Details, fmt.Sprintf("Carries the %s eye view", eyeView(p&0x01 != 0)))
+		switch (p >> 1) & 0x03 {
+		case 0x00:
+			stereo.Details = append(stereo.Details, "No mirroring")
+		case 0x01:
+			stereo.Details = append(stereo.Details, "Left/right mirroring")
+		case 0x02:
+			stereo.Details = append(stereo.Details, "Top/bottom mirroring")
+		default:
+			stereo.Details = append(stereo.Details, "Reserved mirroring")
+		}
+	case 0x04:
+		stereo.Method = "Multi-view"
+		stereo.Details = append(stereo.Details, fmt.Sprintf("Views: %d, interleaving method code: %d", param(0), param(1)))
+	case 0x05:
+		stereo.Method = "Stacked frame"
+		stereo.Details = append(stereo.Details, fmt.Sprintf("Top half: %s eye view", eyeView(param(0)&0x01 != 0)))
+	case 0xFF:
+		stereo.Method = "Proprietary"
+	default:
+		stereo.Method = fmt.Sprintf("Reserved (0x%02x)", stereo.MethodCode)
+	}
+
+	if data[1]&DISPLAYID_STEREO_HAS_CODES == 0 {
+		return &stereo
+	}
+	codes := payload[1+int(payload[0]):]
+	for len(codes) > 0 {
+		codeType := int(codes[0]&DISPLAYID_STEREO_CODE_TYPE_MASK) >> 6
+		count := int(codes[0] & DISPLAYID_STEREO_CODE_COUNT_MASK)
+		if 1+count > len(codes) {
+			*warnings = append(*warnings, "Stereo display interface timing codes exceed the block")
+			count = len(codes) - 1
+		}
+		for _, code := range codes[1 : 1+count] {
+			timing, ok := displayIDTimingCode(codeType, int(code))
+			if !ok {
+				*warnings = append(*warnings, fmt.Sprintf("Stereo display interface timing code %s is unknown", timing.Code))
+				continue
+			}
+			stereo.Timings = append(stereo.Timings, timing)
+		}
+		codes = codes[1+count:]
+	}
+	return &stereo
+}
+
+func parseContainerID(data []byte, warnings *[]string) string {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_CONTAINER_ID_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("ContainerID block length %d is too short", len(payload)))
+		return ""
+	}
+	id := payload[:DISPLAYID_CONTAINER_ID_SIZE]
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16])
+}
+
+func parseAdaptiveSync(data []byte, warnings *[]string) []DisplayIDAdaptiveSync {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	descriptorSize := DISPLAYID_ADAPTIVE_SYNC_SIZE + int(data[1]&DISPLAYID_ADAPTIVE_SYNC_EXTRA_MASK)>>4
+	if len(payload)%descriptorSize != 0 {
+		*warnings = append(*warnings, fmt.Sprintf("Adaptive sync block length %d is not a multiple of %d", len(payload), descriptorSize))
+	}
+
+	descriptors := make([]DisplayIDAdaptiveSync, 0)
+	for offset := 0; offset+descriptorSize <= len(payload); offset += descriptorSize {
+		d := payload[offset : offset+descriptorSize]
+		var sync DisplayIDAdaptiveSync
+		sync.NativePanelRange = d[0]&DISPLAYID_ADAPTIVE_SYNC_NATIVE != 0
+		switch (d[0] & DISPLAYID_ADAPTIVE_SYNC_VTOTAL_MASK) >> 2 {
+		case 0x00:
+			sync.VTotal = "Fixed average V-Total"
+		case 0x01:
+			sync.VTotal = "Fixed average V-Total and adaptive V-Total"
+		default:
+			sync.VTotal = "Reserved"
+		}
+		sync.SeamlessTransition = d[0]&DISPLAYID_ADAPTIVE_SYNC_SEAMLESS != 0
+		sync.MaxIncreaseNoJitter = d[0]&DISPLAYID_ADAPTIVE_SYNC_INC_NO_JITTER != 0
+		sync.MaxDecreaseNoJitter = d[0]&DISPLAYID_ADAPTIVE_SYNC_DEC_NO_JITTER != 0
+		sync.MaxDurationIncrease = float64(d[1]) / 4.0
+		sync.MinRefreshRate = int(d[2])
+		sync.MaxRefreshRate = int(d[4]&DISPLAYID_ADAPTIVE_SYNC_MAX_MSB_MASK)<<8 | int(d[3]) + 1
+		sync.MaxDurationDecrease = float64(d[5]) / 4.0
+		if sync.MinRefreshRate > sync.MaxRefreshRate {
+			*warnings = append(*warnings, fmt.Sprintf("Adaptive sync min refresh rate %d Hz exceeds max refresh rate %d Hz", sync.MinRefreshRate, sync.MaxRefreshRate))
+		}
+		descriptors = append(descriptors, sync)
+	}
+	return descriptors
+}
+
+func parseDisplayIDVendorSpecific(block *DisplayIDBlock, data []byte, warnings *[]string) {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_OUI_SIZE {
+		*warnings = append(*warnings, "Vendor-specific block is too short to hold an OUI")
+		return
+	}
+	// DisplayID stores the OUI most significant byte first
+	block.OUI = uint32(payload[0])<<16 | uint32(payload[1])<<8 | uint32(payload[2])
+	block.VendorData = payload[DISPLAYID_OUI_SIZE:]
+}
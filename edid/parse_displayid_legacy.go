@@ -0,0 +1,362 @@
+package edid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// displayIDAspectRatios holds the width and height of the aspect ratio codes
+// used by the DisplayID timing options
+var displayIDAspectRatios = [][2]int{
+	{1, 1},
+	{5, 4},
+	{4, 3},
+	{15, 9},
+	{16, 9},
+	{16, 10},
+	{64, 27},
+	{256, 135},
+}
+
+var displayIDLegacyInterfaces = []string{
+	"Analog",
+	"LVDS",
+	"TMDS",
+	"RSDS",
+	"DVI-D",
+	"DVI-I, analog",
+	"DVI-I, digital",
+	"HDMI-A",
+	"HDMI-B",
+	"MDDI",
+	"DisplayPort",
+	"Proprietary digital",
+}
+
+var displayIDAnalogInterfaces = []string{
+	"15HD/VGA",
+	"VESA NAVI-V",
+	"VESA NAVI-D",
+}
+
+var displayIDContentProtection = []string{
+	"None",
+	"HDCP",
+	"DTCP",
+	"DPCP",
+}
+
+func parseColorCharacteristics(data []byte, warnings *[]string) *DisplayIDColorCharacteristics {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < 1 {
+		*warnings = append(*warnings, "Color characteristics block is empty")
+		return nil
+	}
+	var color DisplayIDColorCharacteristics
+	color.CIE1976 = data[1]&DISPLAYID_COLOR_CIE_1976 != 0
+	color.TransferID = int(data[1]&DISPLAYID_COLOR_TRANSFER_ID_MASK) >> 3
+	color.Temporal = payload[0]&DISPLAYID_COLOR_TEMPORAL != 0
+	primaries := int(payload[0]&DISPLAYID_COLOR_PRIMARIES_MASK) >> 4
+	whitePoints := int(payload[0] & DISPLAYID_COLOR_WHITE_POINTS_MASK)
+
+	offset := 1
+	if primaries == 0 {
+		// Without primaries the block names a color space instead
+		if len(payload) < 2 {
+			*warnings = append(*warnings, "Color characteristics block is missing the color space")
+			return &color
+		}
+		color.ColorSpace = "Reserved"
+		if int(payload[1]) < len(displayIDColorSpaces) {
+			color.ColorSpace = displayIDColorSpaces[payload[1]]
+		}
+		offset++
+	}
+	if offset+3*(primaries+whitePoints) > len(payload) {
+		*warnings = append(*warnings, "Color characteristics coordinates exceed the block")
+		return &color
+	}
+	for i := 0; i < primaries; i++ {
+		x, y := parseColorPrimary(payload[offset : offset+3])
+		color.Primaries = append(color.Primaries, ColorPoint{X: x, Y: y})
+		offset += 3
+	}
+	for i := 0; i < whitePoints; i++ {
+		x, y := parseColorPrimary(payload[offset : offset+3])
+		color.WhitePoints = append(color.WhitePoints, ColorPoint{X: x, Y: y})
+		offset += 3
+	}
+	return &color
+}
+
+func parseVTBType2(vtb []byte) []DisplayIDTiming {
+	numberOfPayloadBytes := int(vtb[2])
+	timings := make([]DisplayIDTiming, 0)
+
+	offset := 3
+	for i := 0; i < numberOfPayloadBytes/CTA_VTB_TYPE_2_DESCRIPTOR_SIZE; i++ {
+		d := vtb[offset : offset+CTA_VTB_TYPE_2_DESCRIPTOR_SIZE]
+		var timing DisplayIDTiming
+		// Type II pixel clocks are stored in 10 kHz steps
+		timing.PixelClock = float64(int(d[2])<<16|int(d[1])<<8|int(d[0])+1) * 0.01
+		timing.Options = d[3]
+		timing.Preferred = d[3]&DISPLAYID_TIMING_PREFERRED != 0
+		timing.Stereo = displayIDStereo((d[3] & DISPLAYID_TIMING_STEREO_MASK) >> 5)
+		timing.Interlaced = d[3]&DISPLAYID_TIMING_INTERLACED != 0
+		timing.AspectRatio = displayIDAspectRatio(0x08)
+		timing.HorizontalSyncPositive = d[3]&DISPLAYID_TYPE_2_HSYNC_POSITIVE != 0
+		timing.VerticalSyncPositive = d[3]&DISPLAYID_TYPE_2_VSYNC_POSITIVE != 0
+
+		// Horizontal values are stored minus one in 8 pixel units
+		timing.HorizontalActive = (int(d[5]&0x01)<<8 | int(d[4]) + 1) * 8
+		timing.HorizontalBlanking = (int(d[5]>>1) + 1) * 8
+		timing.HorizontalFrontPorch = (int(d[6]>>4) + 1) * 8
+		timing.HorizontalSyncWidth = (int(d[6]&0x0F) + 1) * 8
+
+		timing.VerticalActive = int(d[8]&0x0F)<<8 | int(d[7]) + 1
+		timing.VerticalBlanking = int(d[9]) + 1
+		timing.VerticalFrontPorch = int(d[10]>>4) + 1
+		timing.VerticalSyncWidth = int(d[10]&0x0F) + 1
+
+		timing.RefreshRate = timing.Timing.refreshRate()
+		timings = append(timings, timing)
+		offset += CTA_VTB_TYPE_2_DESCRIPTOR_SIZE
+	}
+
+	return timings
+}
+
+func parseVTBType3(vtb []byte, warnings *[]string) []DisplayIDTiming {
+	numberOfPayloadBytes := int(vtb[2])
+	timings := make([]DisplayIDTiming, 0)
+
+	offset := 3
+	for i := 0; i < numberOfPayloadBytes/CTA_VTB_TYPE_3_DESCRIPTOR_SIZE; i++ {
+		d := vtb[offset : offset+CTA_VTB_TYPE_3_DESCRIPTOR_SIZE]
+		offset += CTA_VTB_TYPE_3_DESCRIPTOR_SIZE
+
+		aspect := int(d[0] & DISPLAYID_TIMING_ASPECT_MASK)
+		if aspect >= len(displayIDAspectRatios) {
+			*warnings = append(*warnings, fmt.Sprintf("VTB type 3 aspect ratio %s cannot be expanded", displayIDAspectRatio(byte(aspect))))
+			continue
+		}
+		blanking := CVT_STANDARD_BLANKING
+		formula := "CVT"
+		switch (d[0] & DISPLAYID_TYPE_3_FORMULA_MASK) >> 4 {
+		case 0x00:
+		case 0x01:
+			blanking = CVT_REDUCED_BLANKING
+			formula = "CVT-RB"
+		default:
+			*warnings = append(*warnings, fmt.Sprintf("VTB type 3 timing formula %d is reserved", (d[0]&DISPLAYID_TYPE_3_FORMULA_MASK)>>4))
+			continue
+		}
+
+		hActive := (int(d[1]) + 1) * 8
+		ratio := displayIDAspectRatios[aspect]
+		vActive := hActive * ratio[1] / ratio[0]
+		refreshRate := int(d[2]&DISPLAYID_TYPE_3_REFRESH_MASK) + 1
+		if d[2]&DISPLAYID_TYPE_3_INTERLACED != 0 {
+			*warnings = append(*warnings, fmt.Sprintf("VTB type 3 interlaced timing %dx%d is expanded as progressive", hActive, vActive))
+		}
+
+		timing := DisplayIDTiming{
+			Timing:      cvtTiming(hActive, vActive, float64(refreshRate), blanking, false),
+			Options:     d[0],
+			Preferred:   d[0]&DISPLAYID_TIMING_PREFERRED != 0,
+			Stereo:      displayIDStereo(0),
+			AspectRatio: displayIDAspectRatio(byte(aspect)),
+			Formula:     formula,
+		}
+		timings = append(timings, timing)
+	}
+
+	return timings
+}
+
+func parseVTBType4(vtb []byte, warnings *[]string) []DisplayIDTiming {
+	codeType := int(vtb[1]&DISPLAYID_TYPE_4_CODE_MASK) >> 6
+	timings := make([]DisplayIDTiming, 0)
+	for _, code := range vtb[DISPLAYID_BLOCK_HEADER_SIZE:] {
+		timing, ok := displayIDTimingCode(codeType, int(code))
+		if !ok {
+			*warnings = append(*warnings, fmt.Sprintf("VTB type 4 timing code %s is unknown", timing.Code))
+			continue
+		}
+		timings = append(timings, timing)
+	}
+	return timings
+}
+
+// parseTimingBitmap decodes the VESA and CTA-861 timing blocks where bit n
+// of the bitmap indicates support for timing code n+1
+func parseTimingBitmap(data []byte, codeType int, size int, warnings *[]string) []DisplayIDTiming {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < size {
+		*warnings = append(*warnings, fmt.Sprintf("Timing bitmap block 0x%02x length %d is too short", data[0], len(payload)))
+		return nil
+	}
+	timings := make([]DisplayIDTiming, 0)
+	for i := 0; i < size*8; i++ {
+		if payload[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		timing, ok := displayIDTimingCode(codeType, i+1)
+		if !ok {
+			*warnings = append(*warnings, fmt.Sprintf("Timing bitmap block 0x%02x timing %s is unknown", data[0], timing.Code))
+			continue
+		}
+		timings = append(timings, timing)
+	}
+	return timings
+}
+
+func parseRangeLimits(data []byte, warnings *[]string) *DisplayIDRangeLimits {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_RANGE_LIMITS_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("Video timing range limits block length %d is too short", len(payload)))
+		return nil
+	}
+	var limits DisplayIDRangeLimits
+	limits.MinPixelClock = float64(int(payload[2])<<16|int(payload[1])<<8|int(payload[0])+1) * 0.01
+	limits.MaxPixelClock = float64(int(payload[5])<<16|int(payload[4])<<8|int(payload[3])+1) * 0.01
+	limits.MinHorizontalRate = int(payload[6])
+	limits.MaxHorizontalRate = int(payload[7])
+	limits.MinHBlank = int(payload[9])<<8 | int(payload[8])
+	limits.MinVerticalRate = int(payload[10])
+	limits.MaxVerticalRate = int(payload[11])
+	limits.MinVBlank = int(payload[13])<<8 | int(payload[12])
+	limits.Interlaced = payload[14]&DISPLAYID_RANGE_INTERLACED != 0
+	limits.CVT = payload[14]&DISPLAYID_RANGE_CVT != 0
+	limits.CVTReduced = payload[14]&DISPLAYID_RANGE_CVT_RB != 0
+	limits.DiscreteFrequency = payload[14]&DISPLAYID_RANGE_DISCRETE != 0
+	if limits.MinPixelClock > limits.MaxPixelClock {
+		*warnings = append(*warnings, fmt.Sprintf("Video timing range limits min pixel clock %.2f MHz exceeds max pixel clock %.2f MHz", limits.MinPixelClock, limits.MaxPixelClock))
+	}
+	return &limits
+}
+
+func parseDisplayIDString(data []byte) string {
+	return strings.TrimRight(string(data[DISPLAYID_BLOCK_HEADER_SIZE:]), "\x00")
+}
+
+func parsePowerSequencing(data []byte, warnings *[]string) *DisplayIDPowerSequencing {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_POWER_SEQUENCING_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("Power sequencing block length %d is too short", len(payload)))
+		return nil
+	}
+	// Only T1 holds a minimum (0.1 ms steps) and a maximum (2 ms steps), T2
+	// and T3 hold a maximum in 2 ms steps and T4 to T6 a minimum in 10 ms steps
+	return &DisplayIDPowerSequencing{
+		T1Min: float64(payload[0]>>4) * 0.1,
+		T1Max: float64(payload[0]&0x0F) * 2,
+		T2Max: float64(payload[1]) * 2,
+		T3Max: float64(payload[2]) * 2,
+		T4Min: float64(payload[3]) * 10,
+		T5Min: float64(payload[4]) * 10,
+		T6Min: float64(payload[5]) * 10,
+	}
+}
+
+func parseTransferCharacteristics(data []byte, warnings *[]string) *DisplayIDTransferCharacteristics {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < 1 {
+		*warnings = append(*warnings, "Transfer characteristics block is empty")
+		return nil
+	}
+	var transfer DisplayIDTransferCharacteristics
+	transfer.ID = int(data[1]&DISPLAYID_TRANSFER_ID_MASK) >> 4
+	transfer.FirstIsWhite = payload[0]&DISPLAYID_TRANSFER_WHITE_FIRST != 0
+	transfer.IndividualCurves = payload[0]&DISPLAYID_TRANSFER_INDIVIDUAL != 0
+	parameters := payload[0]&DISPLAYID_TRANSFER_PARAMETERS != 0
+
+	curves := payload[1:]
+	for len(curves) > 0 {
+		var curve DisplayIDTransferCurve
+		if transfer.FirstIsWhite && len(transfer.Curves) == 0 {
+			curve.Name = "White"
+		} else if transfer.FirstIsWhite {
+			curve.Name = fmt.Sprintf("Response curve %d", len(transfer.Curves))
+		} else {
+			curve.Name = fmt.Sprintf("Response curve %d", len(transfer.Curves)+1)
+		}
+
+		// The sample count includes the count byte itself
+		samples := int(curves[0])
+		if parameters {
+			samples++
+		}
+		if samples == 0 || samples > len(curves) {
+			*warnings = append(*warnings, fmt.Sprintf("Transfer characteristics %s exceeds the block", strings.ToLower(curve.Name)))
+			break
+		}
+		if parameters {
+			if samples != 6 {
+				*warnings = append(*warnings, fmt.Sprintf("Transfer characteristics %s has %d parameters instead of 5", strings.ToLower(curve.Name), samples-1))
+			} else {
+				curve.Parameters = []int{int(curves[1]), int(curves[2]), int(curves[3]), int(curves[4])}
+				curve.Gamma = displayIDScaledByte(curves[5])
+			}
+		} else {
+			// Samples are stored as increments, the curve always ends at full scale
+			sum := 0
+			for _, sample := range curves[1:samples] {
+				sum += int(sample)
+				curve.Samples = append(curve.Samples, float64(sum)*100.0/DISPLAYID_TRANSFER_MAX_SAMPLE)
+			}
+			curve.Samples = append(curve.Samples, 100.0)
+		}
+		transfer.Curves = append(transfer.Curves, curve)
+		curves = curves[samples:]
+	}
+	return &transfer
+}
+
+func parseLegacyInterface(data []byte, warnings *[]string) *DisplayIDLegacyInterface {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_INTERFACE_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("Display interface block length %d is too short", len(payload)))
+		return nil
+	}
+	var iface DisplayIDLegacyInterface
+	interfaceType := int(payload[0]&DISPLAYID_INTERFACE_TYPE_MASK) >> 4
+	channels := int(payload[0] & DISPLAYID_INTERFACE_CHANNELS_MASK)
+	switch {
+	case interfaceType == 0:
+		if channels < len(displayIDAnalogInterfaces) {
+			iface.Type = fmt.Sprintf("Analog, %s", displayIDAnalogInterfaces[channels])
+		} else {
+			iface.Type = "Analog, reserved"
+		}
+	case interfaceType < len(displayIDLegacyInterfaces):
+		iface.Type = displayIDLegacyInterfaces[interfaceType]
+		iface.Channels = channels
+	default:
+		iface.Type = fmt.Sprintf("Reserved (%d)", interfaceType)
+		iface.Channels = channels
+	}
+	iface.Version = fmt.Sprintf("%d.%d", payload[1]>>4, payload[1]&0x0F)
+	iface.RGBDepths = displayIDBitDepthList(payload[2], displayIDRGBDepths)
+	iface.YCbCr444Depths = displayIDBitDepthList(payload[3], displayIDRGBDepths)
+	iface.YCbCr422Depths = displayIDBitDepthList(payload[4], displayIDYCbCrDepths)
+
+	iface.ContentProtection = "Reserved"
+	if int(payload[5]) < len(displayIDContentProtection) {
+		iface.ContentProtection = displayIDContentProtection[payload[5]]
+	}
+	iface.ContentProtectionVersion = fmt.Sprintf("%d.%d", payload[6]>>4, payload[6]&0x0F)
+
+	switch (payload[7] & DISPLAYID_SPREAD_SPECTRUM_MASK) >> 6 {
+	case 0x00:
+		iface.SpreadSpectrum = "None"
+	case 0x01:
+		iface.SpreadSpectrum = "Down spread"
+	case 0x02:
+		iface.SpreadSpectrum = "Center spread"
+	default:
+		iface.SpreadSpectrum = "Reserved"
+	}
+	iface.SpreadSpectrumPercent = float64(payload[7]&DISPLAYID_SPREAD_PERCENT_MASK) / 10.0
+	return &iface
+}
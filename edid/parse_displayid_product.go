@@ -0,0 +1,184 @@
+package edid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+var displayIDScanOrientations = []string{
+	"Left to right, top to bottom",
+	"Right to left, top to bottom",
+	"Top to bottom, right to left",
+	"Bottom to top, right to left",
+	"Right to left, bottom to top",
+	"Left to right, bottom to top",
+	"Bottom to top, left to right",
+	"Top to bottom, left to right",
+}
+
+var displayIDLegacyFeatures = []string{
+	"De-interlacing",
+	"ACP, ISRC1 or ISRC2 packets",
+	"Fixed pixel format",
+	"Fixed timing",
+	"Power management (DPM)",
+	"Audio input override",
+	"Separate audio inputs",
+	"Audio support on video interface",
+}
+
+var displayIDBitDepths = []int{0, 6, 8, 10, 12, 16}
+
+// halfFloat decodes an IEEE 754 half-precision floating point value
+func halfFloat(v uint16) float64 {
+	exponent := int(v>>10) & 0x1F
+	mantissa := float64(v & 0x3FF)
+	var value float64
+	switch exponent {
+	case 0x00:
+		value = mantissa / 1024.0 * math.Pow(2, -14)
+	case 0x1F:
+		value = math.Inf(1)
+	default:
+		value = (1.0 + mantissa/1024.0) * math.Pow(2, float64(exponent-15))
+	}
+	if v&0x8000 != 0 {
+		return -value
+	}
+	return value
+}
+
+// displayIDScaledByte decodes a value from 1.00 to 3.54 stored as
+// (value * 100) - 100, used for gamma and aspect ratio. 0 means not specified
+func displayIDScaledByte(b byte) float64 {
+	if b == DISPLAYID_SCALED_UNDEFINED {
+		return 0
+	}
+	return float64(int(b)+100) / 100.0
+}
+
+func parseProductIdentification(data []byte, legacy bool, warnings *[]string) *DisplayIDProductIdentification {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if len(payload) < DISPLAYID_PRODUCT_ID_MIN_LEN {
+		*warnings = append(*warnings, fmt.Sprintf("Product identification block length %d is too short", len(payload)))
+		return nil
+	}
+	var product DisplayIDProductIdentification
+	if legacy {
+		product.VendorID = string(payload[0:3])
+	} else {
+		product.VendorID = fmt.Sprintf("%02X-%02X-%02X", payload[0], payload[1], payload[2])
+	}
+	product.ProductCode = binary.LittleEndian.Uint16(payload[3:5])
+	product.SerialNumber = binary.LittleEndian.Uint32(payload[5:9])
+	if payload[9] == DISPLAYID_MODEL_YEAR {
+		product.ModelYear = true
+	} else {
+		product.WeekOfManufacture = int(payload[9])
+	}
+	product.YearOfManufacture = int(payload[10]) + 2000
+
+	nameLength := int(payload[11])
+	if DISPLAYID_PRODUCT_ID_MIN_LEN+nameLength > len(payload) {
+		*warnings = append(*warnings, "Product identification name exceeds the block")
+		nameLength = len(payload) - DISPLAYID_PRODUCT_ID_MIN_LEN
+	}
+	product.ProductName = string(payload[DISPLAYID_PRODUCT_ID_MIN_LEN : DISPLAYID_PRODUCT_ID_MIN_LEN+nameLength])
+	return &product
+}
+
+func parseDisplayParametersLegacy(payload []byte) *DisplayIDDisplayParameters {
+	var params DisplayIDDisplayParameters
+	params.ImageWidth = float64(binary.LittleEndian.Uint16(payload[0:2])) / 10.0
+	params.ImageHeight = float64(binary.LittleEndian.Uint16(payload[2:4])) / 10.0
+	params.HorizontalPixels = int(binary.LittleEndian.Uint16(payload[4:6]))
+	params.VerticalPixels = int(binary.LittleEndian.Uint16(payload[6:8]))
+	for i, feature := range displayIDLegacyFeatures {
+		if payload[8]&(1<<i) != 0 {
+			params.Features = append(params.Features, feature)
+		}
+	}
+	params.Gamma = displayIDScaledByte(payload[9])
+	params.AspectRatio = displayIDScaledByte(payload[10])
+	params.NativeBitDepth = int(payload[11]&0x0F) + 1
+	params.OverallBitDepth = int(payload[11]>>4) + 1
+	return &params
+}
+
+// parseColorPrimary decodes a 12-bit x,y coordinate pair packed into 3 bytes
+func parseColorPrimary(p []byte) (float64, float64) {
+	x := int(p[1]&0x0F)<<8 | int(p[0])
+	y := int(p[2])<<4 | int(p[1]>>4)
+	return float64(x) / 4096.0, float64(y) / 4096.0
+}
+
+func parseDisplayParameters(data []byte, legacy bool, warnings *[]string) *DisplayIDDisplayParameters {
+	payload := data[DISPLAYID_BLOCK_HEADER_SIZE:]
+	if legacy {
+		if len(payload) < DISPLAYID_PARAMS_LEGACY_SIZE {
+			*warnings = append(*warnings, fmt.Sprintf("Display parameters block length %d is too short", len(payload)))
+			return nil
+		}
+		return parseDisplayParametersLegacy(payload)
+	}
+	if len(payload) < DISPLAYID_PARAMS_SIZE {
+		*warnings = append(*warnings, fmt.Sprintf("Display parameters block length %d is too short", len(payload)))
+		return nil
+	}
+
+	var params DisplayIDDisplayParameters
+	sizeStep := 0.1
+	if data[1]&DISPLAYID_PARAMS_SIZE_MM != 0 {
+		sizeStep = 1.0
+	}
+	params.ImageWidth = float64(binary.LittleEndian.Uint16(payload[0:2])) * sizeStep
+	params.ImageHeight = float64(binary.LittleEndian.Uint16(payload[2:4])) * sizeStep
+	params.HorizontalPixels = int(binary.LittleEndian.Uint16(payload[4:6]))
+	params.VerticalPixels = int(binary.LittleEndian.Uint16(payload[6:8]))
+
+	features := payload[8]
+	params.ScanOrientation = displayIDScanOrientations[features&DISPLAYID_PARAMS_SCAN_MASK]
+	switch (features & DISPLAYID_PARAMS_LUMINANCE_MASK) >> 3 {
+	case 0x00:
+		params.LuminanceInformation = "Minimum guaranteed value"
+	case 0x01:
+		params.LuminanceInformation = "Guidance for the source device"
+	default:
+		params.LuminanceInformation = "Reserved"
+	}
+	params.AudioIntegrated = features&DISPLAYID_PARAMS_NO_AUDIO == 0
+	params.ColorSpace = "CIE 1931"
+	if features&DISPLAYID_PARAMS_CIE_1976 != 0 {
+		params.ColorSpace = "CIE 1976"
+	}
+
+	primaries := &params.Primaries
+	primaries.RedX, primaries.RedY = parseColorPrimary(payload[9:12])
+	primaries.GreenX, primaries.GreenY = parseColorPrimary(payload[12:15])
+	primaries.BlueX, primaries.BlueY = parseColorPrimary(payload[15:18])
+	primaries.WhiteX, primaries.WhiteY = parseColorPrimary(payload[18:21])
+
+	params.MaxLuminanceFull = halfFloat(binary.LittleEndian.Uint16(payload[21:23]))
+	params.MaxLuminance10 = halfFloat(binary.LittleEndian.Uint16(payload[23:25]))
+	params.MinLuminance = halfFloat(binary.LittleEndian.Uint16(payload[25:27]))
+
+	depth := int(payload[27] & DISPLAYID_PARAMS_BIT_DEPTH_MASK)
+	if depth < len(displayIDBitDepths) {
+		params.BitDepth = displayIDBitDepths[depth]
+	} else {
+		*warnings = append(*warnings, fmt.Sprintf("Display parameters color bit depth %d is reserved", depth))
+	}
+	switch (payload[27] & DISPLAYID_PARAMS_TECHNOLOGY_MASK) >> 4 {
+	case 0x00:
+		params.Technology = "Not specified"
+	case 0x01:
+		params.Technology = "Active matrix LCD"
+	case 0x02:
+		params.Technology = "Organic LED"
+	default:
+		params.Technology = "Reserved"
+	}
+	params.Gamma = displayIDScaledByte(payload[28])
+	return &params
+}
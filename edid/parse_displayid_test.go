@@ -0,0 +1,103 @@
+package edid
+
+import "testing"
+
+// testTiledTopologyBlock is a DisplayID 2.0 tiled display topology block for
+// a 40 x 20 wall, tile 33, 17 of 3840 x 2160 pixels, pixel multiplier 4,
+// bezels of 4, 6, 10 and 2 pixels and topology ID 00-0C-03 0x1234 0x12345678
+var testTiledTopologyBlock = []byte{
+	0x28, 0x00, 22, 0xC9,
+	0x73, 0x11, 0x99,
+	0xFF, 0x0E, 0x6F, 0x08,
+	4, 10, 15, 25, 5,
+	0x00, 0x0C, 0x03, 0x34, 0x12, 0x78, 0x56, 0x34, 0x12,
+}
+
+func TestParseTiledDisplayTopology(t *testing.T) {
+	tests := []struct {
+		name  string
+		block []byte
+		want  TiledDisplayTopology
+	}{
+		{
+			name: "DisplayID 1.3",
+			block: []byte{
+				0x12, 0x00, 22, 0x82,
+				0x71, 0x71, 0x55,
+				0x7F, 0x07, 0x37, 0x04,
+				0, 0, 0, 0, 0,
+				'O', 'P', 'S', 0xCD, 0xAB, 0x01, 0x00, 0x00, 0x00,
+			},
+			want: TiledDisplayTopology{
+				OneTileBehavior:    0x02,
+				SingleEnclosure:    true,
+				HorizontalTiles:    24,
+				VerticalTiles:      18,
+				HorizontalLocation: 23,
+				VerticalLocation:   17,
+				TileWidth:          1920,
+				TileHeight:         1080,
+				VendorID:           "OPS",
+				ProductCode:        0xABCD,
+				SerialNumber:       1,
+			},
+		},
+		{
+			name:  "DisplayID 2.0",
+			block: testTiledTopologyBlock,
+			want: TiledDisplayTopology{
+				OneTileBehavior:    0x01,
+				NTileBehavior:      0x01,
+				BezelInformation:   true,
+				SingleEnclosure:    true,
+				HorizontalTiles:    40,
+				VerticalTiles:      20,
+				HorizontalLocation: 33,
+				VerticalLocation:   17,
+				TileWidth:          3840,
+				TileHeight:         2160,
+				PixelMultiplier:    4,
+				TopBezel:           4,
+				BottomBezel:        6,
+				RightBezel:         10,
+				LeftBezel:          2,
+				VendorID:           "00-0C-03",
+				ProductCode:        0x1234,
+				SerialNumber:       0x12345678,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseTiledDisplayTopology(tt.block)
+			if got != tt.want {
+				t.Errorf("parseTiledDisplayTopology()\ngot  %+v\nwant %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeTiledDisplayTopologyExtension(t *testing.T) {
+	ext, err := generateDisplayIDExtension(testTiledTopologyBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var warnings []string
+	displayID, err := decodeDisplayIDExtension(&ext, &warnings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+	if len(displayID.Sections) != 1 || len(displayID.Sections[0].Blocks) != 1 {
+		t.Fatalf("got %+v, want a single section with a single block", displayID.Sections)
+	}
+	tile := displayID.Sections[0].Blocks[0].TiledTopology
+	if tile == nil {
+		t.Fatal("tiled display topology missing")
+	}
+	if tile.HorizontalTiles != 40 || tile.VerticalTiles != 20 || tile.HorizontalLocation != 33 || tile.VerticalLocation != 17 {
+		t.Errorf("got %d x %d tiles at %d, %d, want 40 x 20 tiles at 33, 17", tile.HorizontalTiles, tile.VerticalTiles, tile.HorizontalLocation, tile.VerticalLocation)
+	}
+}
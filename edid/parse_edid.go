@@ -10,200 +10,309 @@ func manIdByteToChar(b byte) byte {
 	return b + 0x40
 }
 
-func parseBDP(bdp []byte) {
+func parseBDP(bdp []byte) BasicDisplayParameters {
+	var params BasicDisplayParameters
 	displayType := bdp[0] & BDP_DIGITAL_INPUT
 	if displayType != 0 {
-		fmt.Println("\tDigital Input")
+		params.Digital = true
 		bitDepth := (bdp[0] & BDP_BIT_DEPTH) >> 4
-		switch bitDepth {
-		case 0x00:
-			fmt.Println("\tUndefined")
-		case 0x01:
-			fmt.Println("\t6 bits per color")
-		case 0x02:
-			fmt.Println("\t8 bits per color")
-		case 0x03:
-			fmt.Println("\t10 bits per color")
-		case 0x04:
-			fmt.Println("\t12 bits per color")
-		case 0x05:
-			fmt.Println("\t14 bits per color")
-		case 0x06:
-			fmt.Println("\t16 bits per color")
-		default:
-			fmt.Println("\tReserved")
+		if bitDepth >= 0x01 && bitDepth <= 0x06 {
+			params.BitDepth = 4 + 2*int(bitDepth)
 		}
 		videoInterface := bdp[0] & BDP_VIDEO_INTERFACE
 		switch videoInterface {
 		case 0x00:
-			fmt.Println("\tUndefined")
+			params.VideoInterface = "Undefined"
 		case 0x01:
-			fmt.Println("\tDVI")
+			params.VideoInterface = "DVI"
 		case 0x02:
-			fmt.Println("\tHDMI-a")
+			params.VideoInterface = "HDMI-a"
 		case 0x03:
-			fmt.Println("\tHDMI-b")
+			params.VideoInterface = "HDMI-b"
 		case 0x04:
-			fmt.Println("\tMDDI")
+			params.VideoInterface = "MDDI"
 		case 0x05:
-			fmt.Println("\tDisplayPort")
+			params.VideoInterface = "DisplayPort"
 		default:
-			fmt.Println("\tReserved")
+			params.VideoInterface = "Reserved"
 		}
 	} else {
-		fmt.Println("\tAnalog Input")
 		videoWhiteAndSyncLevels := (bdp[0] & BDP_VIDEO_WHITE_AND_SYNC_LEVELS) >> 5
 		switch videoWhiteAndSyncLevels {
 		case 0x00:
-			fmt.Println("\t0.7/0.3 V")
+			params.WhiteAndSyncLevels = "0.7/0.3 V"
 		case 0x01:
-			fmt.Println("\t0.714/0.286 V")
+			params.WhiteAndSyncLevels = "0.714/0.286 V"
 		case 0x02:
-			fmt.Println("\t1.0/0.4 V")
+			params.WhiteAndSyncLevels = "1.0/0.4 V"
 		case 0x03:
-			fmt.Println("\t0.7/0.0 V")
-		default:
-			fmt.Println("\tReserved")
-
+			params.WhiteAndSyncLevels = "0.7/0.0 V"
 		}
-		blankToBlackSetup := (bdp[0] & BDP_BLANK_TO_BLACK_SETUP) >> 4
-		if blankToBlackSetup == 0x01 {
-			fmt.Println("\tBlank to black setup (pedestal) expected")
-		} else {
-			fmt.Println("\tBlank to black setup (pedestal) not expected")
-		}
-		sepSyncLevels := (bdp[0] & BDP_SYNC_SIGNAL_LEVELS) >> 3
-		if sepSyncLevels == 0x01 {
-			fmt.Println("\tSeparate sync levels supported")
-		} else {
-			fmt.Println("\tSeparate sync levels not supported")
-		}
-		compositeSync := (bdp[0] & BDP_COMPOSITE_SYNC) >> 2
-		if compositeSync == 0x01 {
-			fmt.Println("\tComposite sync supported")
-		} else {
-			fmt.Println("\tComposite sync not supported")
-		}
-		syncOnGreen := (bdp[0] & BDP_SYNC_ON_GREEN) >> 1
-		if syncOnGreen == 0x01 {
-			fmt.Println("\tSync on green supported")
-		} else {
-			fmt.Println("\tSync on green not supported")
-		}
-		vsyncSerrated := bdp[0] & BDP_VSYNC_SERRATED
-		if vsyncSerrated == 0x01 {
-			fmt.Println("\tVsync serrated")
-		} else {
-			fmt.Println("\tVsync not serrated")
-		}
-	}
-	fmt.Println("\tMaximum Image Size: ", bdp[1], "cm x ", bdp[2], "cm")
-	displayGamma := bdp[3] + 100
-	fmt.Println("\tDisplay Gamma: ", float32(displayGamma)/100.0)
+		params.BlankToBlackSetup = bdp[0]&BDP_BLANK_TO_BLACK_SETUP != 0
+		params.SeparateSync = bdp[0]&BDP_SYNC_SIGNAL_LEVELS != 0
+		params.CompositeSync = bdp[0]&BDP_COMPOSITE_SYNC != 0
+		params.SyncOnGreen = bdp[0]&BDP_SYNC_ON_GREEN != 0
+		params.VSyncSerrated = bdp[0]&BDP_VSYNC_SERRATED != 0
+	}
+	params.MaxHorizontalSize = int(bdp[1])
+	params.MaxVerticalSize = int(bdp[2])
+	params.Gamma = float64(int(bdp[3])+100) / 100.0
 	supportedFeatures := bdp[4]
-	if supportedFeatures&0x80 != 0 {
-		fmt.Println("\tDPMS standby supported")
-	}
-	if supportedFeatures&0x40 != 0 {
-		fmt.Println("\tDPMS suspend supported")
-	}
-	if supportedFeatures&0x20 != 0 {
-		fmt.Println("\tDPMS active-off supported")
-	}
+	params.DPMSStandby = supportedFeatures&0x80 != 0
+	params.DPMSSuspend = supportedFeatures&0x40 != 0
+	params.DPMSActiveOff = supportedFeatures&0x20 != 0
 
 	dt := ((supportedFeatures & 0x18) >> 3)
 	if displayType != 0x00 {
 		switch dt {
 		case 0x00:
-			fmt.Println("\tDisplay type: RGB 4:4:4")
+			params.DisplayType = "RGB 4:4:4"
 		case 0x01:
-			fmt.Println("\tDisplay type: RGB 4:4:4 + YCrCb 4:4:4")
+			params.DisplayType = "RGB 4:4:4 + YCrCb 4:4:4"
 		case 0x02:
-			fmt.Println("\tDisplay type: RGB 4:4:4 + YCrCb 4:2:2")
+			params.DisplayType = "RGB 4:4:4 + YCrCb 4:2:2"
 		case 0x03:
-			fmt.Println("\tDisplay type: RGB 4:4:4 + YCrCb 4:4:4 + YCrCb 4:2:2")
+			params.DisplayType = "RGB 4:4:4 + YCrCb 4:4:4 + YCrCb 4:2:2"
 		}
 	} else {
 		switch dt {
 		case 0x00:
-			fmt.Println("\tDisplay type: Monochrome/Grayscale")
+			params.DisplayType = "Monochrome/Grayscale"
 		case 0x01:
-			fmt.Println("\tDisplay type: RGB color")
+			params.DisplayType = "RGB color"
 		case 0x02:
-			fmt.Println("\tDisplay type: Non-RGB color")
+			params.DisplayType = "Non-RGB color"
 		case 0x03:
-			fmt.Println("\tDisplay type: Undefined")
+			params.DisplayType = "Undefined"
 		}
 	}
+	return params
 }
 
-func parseChromaticityCoordinates(cc []byte) {
-	redX := float64(((int((cc[0] & 0xc0) >> 6)) + int((cc[2]))<<2)) / 1024.0
-	redY := float64(((int((cc[0] & 0x30) >> 4)) + int((cc[3]))<<2)) / 1024.0
-	greenX := float64(((int((cc[0] & 0x0c) >> 2)) + int((cc[4]))<<2)) / 1024.0
-	greenY := float64(((int((cc[0] & 0x03))) + int((cc[5]))<<2)) / 1024.0
-	blueX := float64(((int((cc[1] & 0xc0) >> 6)) + int((cc[6]))<<2)) / 1024.0
-	blueY := float64(((int((cc[1] & 0x30) >> 4)) + int((cc[7]))<<2)) / 1024.0
-	whiteX := float64(((int((cc[1] & 0x0c) >> 2)) + int((cc[8]))<<2)) / 1024.0
-	whiteY := float64(((int((cc[1] & 0x03))) + int((cc[9]))<<2)) / 1024.0
-	fmt.Println("\tRed X:", redX, " Red Y:", redY)
-	fmt.Println("\tGreen X:", greenX, " Green Y:", greenY)
-	fmt.Println("\tBlue X:", blueX, " Blue Y:", blueY)
-	fmt.Println("\tWhite X:", whiteX, " White Y:", whiteY)
+func parseChromaticityCoordinates(cc []byte) ChromaticityCoordinates {
+	return ChromaticityCoordinates{
+		RedX:   float64(((int((cc[0] & 0xc0) >> 6)) + int((cc[2]))<<2)) / 1024.0,
+		RedY:   float64(((int((cc[0] & 0x30) >> 4)) + int((cc[3]))<<2)) / 1024.0,
+		GreenX: float64(((int((cc[0] & 0x0c) >> 2)) + int((cc[4]))<<2)) / 1024.0,
+		GreenY: float64(((int((cc[0] & 0x03))) + int((cc[5]))<<2)) / 1024.0,
+		BlueX:  float64(((int((cc[1] & 0xc0) >> 6)) + int((cc[6]))<<2)) / 1024.0,
+		BlueY:  float64(((int((cc[1] & 0x30) >> 4)) + int((cc[7]))<<2)) / 1024.0,
+		WhiteX: float64(((int((cc[1] & 0x0c) >> 2)) + int((cc[8]))<<2)) / 1024.0,
+		WhiteY: float64(((int((cc[1] & 0x03))) + int((cc[9]))<<2)) / 1024.0,
+	}
 }
 
-func parseEstablishedTimings(et []byte) {
-	if et[0]&ESTABLISHED_TIMINGS_720x400_70Hz != 0 {
-		fmt.Println("\t720x400 @ 70Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_720x400_88Hz != 0 {
-		fmt.Println("\t720x400 @ 88Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_640x480_60Hz != 0 {
-		fmt.Println("\t640x480 @ 60Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_640x480_67Hz != 0 {
-		fmt.Println("\t640x480 @ 67Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_640x480_72Hz != 0 {
-		fmt.Println("\t640x480 @ 72Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_640x480_75Hz != 0 {
-		fmt.Println("\t640x480 @ 75Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_800x600_56Hz != 0 {
-		fmt.Println("\t800x600 @ 56Hz")
-	}
-	if et[0]&ESTABLISHED_TIMINGS_800x600_60Hz != 0 {
-		fmt.Println("\t800x600 @ 60Hz")
-	}
-	if et[1]&ESTABLISHED_TIMINGS_800x600_72Hz != 0 {
-		fmt.Println("\t800x600 @ 72Hz")
-	}
-	if et[1]&ESTABLISHED_TIMINGS_800x600_75Hz != 0 {
-		fmt.Println("\t800x600 @ 75Hz")
-	}
-	if et[1]&ESTABLISHED_TIMINGS_832x624_75Hz != 0 {
-		fmt.Println("\t832x624 @ 75Hz")
-	}
-	if et[1]&ESTABLISHED_TIMINGS_1024x768_87Hz != 0 {
-		fmt.Println("\t1024x768 @ 87Hz")
+var establishedTimings = []struct {
+	index int
+	mask  byte
+	EstablishedTiming
+}{
+	{0, ESTABLISHED_TIMINGS_720x400_70Hz, EstablishedTiming{720, 400, 70, false, 0}},
+	{0, ESTABLISHED_TIMINGS_720x400_88Hz, EstablishedTiming{720, 400, 88, false, 0}},
+	{0, ESTABLISHED_TIMINGS_640x480_60Hz, EstablishedTiming{640, 480, 60, false, 0}},
+	{0, ESTABLISHED_TIMINGS_640x480_67Hz, EstablishedTiming{640, 480, 67, false, 0}},
+	{0, ESTABLISHED_TIMINGS_640x480_72Hz, EstablishedTiming{640, 480, 72, false, 0}},
+	{0, ESTABLISHED_TIMINGS_640x480_75Hz, EstablishedTiming{640, 480, 75, false, 0}},
+	{0, ESTABLISHED_TIMINGS_800x600_56Hz, EstablishedTiming{800, 600, 56, false, 0}},
+	{0, ESTABLISHED_TIMINGS_800x600_60Hz, EstablishedTiming{800, 600, 60, false, 0}},
+	{1, ESTABLISHED_TIMINGS_800x600_72Hz, EstablishedTiming{800, 600, 72, false, 0}},
+	{1, ESTABLISHED_TIMINGS_800x600_75Hz, EstablishedTiming{800, 600, 75, false, 0}},
+	{1, ESTABLISHED_TIMINGS_832x624_75Hz, EstablishedTiming{832, 624, 75, false, 0}},
+	{1, ESTABLISHED_TIMINGS_1024x768_87Hz, EstablishedTiming{1024, 768, 87, false, 0}},
+	{1, ESTABLISHED_TIMINGS_1024x768_60Hz, EstablishedTiming{1024, 768, 60, false, 0}},
+	{1, ESTABLISHED_TIMINGS_1024x768_70Hz, EstablishedTiming{1024, 768, 70, false, 0}},
+	{1, ESTABLISHED_TIMINGS_1024x768_75Hz, EstablishedTiming{1024, 768, 75, false, 0}},
+	{1, ESTABLISHED_TIMINGS_1280x1024_75Hz, EstablishedTiming{1280, 1024, 75, false, 0}},
+	{2, ESTABLISHED_TIMINGS_1152x870_75Hz, EstablishedTiming{1152, 870, 75, false, 0}},
+}
+
+// Established timings III, byte offset in the bitmap, bit mask and DMT ID
+var establishedTimingsIII = []struct {
+	index int
+	mask  byte
+	dmtID int
+}{
+	{0, 0x80, 0x01},
+	{0, 0x40, 0x02},
+	{0, 0x20, 0x03},
+	{0, 0x10, 0x07},
+	{0, 0x08, 0x0E},
+	{0, 0x04, 0x0C},
+	{0, 0x02, 0x13},
+	{0, 0x01, 0x15},
+	{1, 0x80, 0x16},
+	{1, 0x40, 0x17},
+	{1, 0x20, 0x18},
+	{1, 0x10, 0x19},
+	{1, 0x08, 0x20},
+	{1, 0x04, 0x21},
+	{1, 0x02, 0x23},
+	{1, 0x01, 0x25},
+	{2, 0x80, 0x27},
+	{2, 0x40, 0x2E},
+	{2, 0x20, 0x2F},
+	{2, 0x10, 0x30},
+	{2, 0x08, 0x31},
+	{2, 0x04, 0x29},
+	{2, 0x02, 0x2A},
+	{2, 0x01, 0x2B},
+	{3, 0x80, 0x2C},
+	{3, 0x40, 0x39},
+	{3, 0x20, 0x3A},
+	{3, 0x10, 0x3B},
+	{3, 0x08, 0x3C},
+	{3, 0x04, 0x33},
+	{3, 0x02, 0x34},
+	{3, 0x01, 0x35},
+	{4, 0x80, 0x36},
+	{4, 0x40, 0x37},
+	{4, 0x20, 0x3E},
+	{4, 0x10, 0x3F},
+	{4, 0x08, 0x41},
+	{4, 0x04, 0x42},
+	{4, 0x02, 0x44},
+	{4, 0x01, 0x45},
+	{5, 0x80, 0x46},
+	{5, 0x40, 0x47},
+	{5, 0x20, 0x49},
+	{5, 0x10, 0x4A},
+}
+
+func parseEstablishedTimings(et []byte) []EstablishedTiming {
+	timings := make([]EstablishedTiming, 0)
+	for _, t := range establishedTimings {
+		if et[t.index]&t.mask != 0 {
+			timings = append(timings, t.EstablishedTiming)
+		}
 	}
-	if et[1]&ESTABLISHED_TIMINGS_1024x768_60Hz != 0 {
-		fmt.Println("\t1024x768 @ 60Hz")
+	return timings
+}
+
+func parseEstablishedTimingsIII(dd [DISPLAY_DESCRIPTOR_SIZE]byte, warnings *[]string) []EstablishedTiming {
+	timings := make([]EstablishedTiming, 0)
+	bitmap := dd[ESTABLISHED_TIMINGS_III_OFFSET:]
+	for _, t := range establishedTimingsIII {
+		if bitmap[t.index]&t.mask == 0 {
+			continue
+		}
+		dmt, found := dmtByID(t.dmtID)
+		if !found {
+			*warnings = append(*warnings, fmt.Sprintf("Established timing III DMT 0x%02x is unknown", t.dmtID))
+			continue
+		}
+		timings = append(timings, EstablishedTiming{dmt.hActive, dmt.vActive, dmt.refreshRate, dmt.reducedBlanking, dmt.id})
 	}
-	if et[1]&ESTABLISHED_TIMINGS_1024x768_70Hz != 0 {
-		fmt.Println("\t1024x768 @ 70Hz")
+	return timings
+}
+
+var cvtCodeRates = []struct {
+	mask            byte
+	refreshRate     int
+	reducedBlanking bool
+}{
+	{CVT_CODE_RATE_50, 50, false},
+	{CVT_CODE_RATE_60, 60, false},
+	{CVT_CODE_RATE_75, 75, false},
+	{CVT_CODE_RATE_85, 85, false},
+	{CVT_CODE_RATE_60_RB, 60, true},
+}
+
+func parseCVTCode(code []byte) CVTCode {
+	var cvt CVTCode
+	// Addressable lines are stored halved and minus one
+	cvt.VerticalActive = (int(code[1]&CVT_CODE_LINES_MSB)<<4 | int(code[0]) + 1) * 2
+	switch (code[1] & CVT_CODE_ASPECT_MASK) >> 2 {
+	case 0x00:
+		cvt.AspectRatio = "4:3"
+		cvt.HorizontalActive = cvt.VerticalActive * 4 / 3
+	case 0x01:
+		cvt.AspectRatio = "16:9"
+		cvt.HorizontalActive = cvt.VerticalActive * 16 / 9
+	case 0x02:
+		cvt.AspectRatio = "16:10"
+		cvt.HorizontalActive = cvt.VerticalActive * 16 / 10
+	case 0x03:
+		cvt.AspectRatio = "15:9"
+		cvt.HorizontalActive = cvt.VerticalActive * 15 / 9
 	}
-	if et[1]&ESTABLISHED_TIMINGS_1024x768_75Hz != 0 {
-		fmt.Println("\t1024x768 @ 75Hz")
+	// Horizontal pixels are rounded down to the 8 pixel character cell
+	cvt.HorizontalActive = cvt.HorizontalActive / 8 * 8
+	cvt.PreferredRefreshRate = []int{50, 60, 75, 85}[(code[2]&CVT_CODE_PREFERRED_MASK)>>5]
+
+	for _, rate := range cvtCodeRates {
+		if code[2]&rate.mask == 0 {
+			continue
+		}
+		blanking := CVT_STANDARD_BLANKING
+		if rate.reducedBlanking {
+			blanking = CVT_REDUCED_BLANKING
+		}
+		timing := CVTCodeTiming{
+			Timing:             cvtTiming(cvt.HorizontalActive, cvt.VerticalActive, float64(rate.refreshRate), blanking, false),
+			NominalRefreshRate: rate.refreshRate,
+			ReducedBlanking:    rate.reducedBlanking,
+		}
+		// Reduced blanking is only preferred when standard blanking is not supported at that rate
+		timing.Preferred = rate.refreshRate == cvt.PreferredRefreshRate &&
+			(!rate.reducedBlanking || code[2]&CVT_CODE_RATE_60 == 0)
+		cvt.Timings = append(cvt.Timings, timing)
 	}
-	if et[1]&ESTABLISHED_TIMINGS_1280x1024_75Hz != 0 {
-		fmt.Println("\t1280x1024 @ 75Hz")
+	return cvt
+}
+
+func parseCVTCodes(dd [DISPLAY_DESCRIPTOR_SIZE]byte, warnings *[]string) []CVTCode {
+	codes := make([]CVTCode, 0, CVT_CODE_COUNT)
+	for i := 0; i < CVT_CODE_COUNT; i++ {
+		code := dd[CVT_CODE_OFFSET+i*CVT_CODE_SIZE : CVT_CODE_OFFSET+(i+1)*CVT_CODE_SIZE]
+		if code[0] == 0x00 && code[1] == 0x00 && code[2] == 0x00 {
+			continue
+		}
+		cvt := parseCVTCode(code)
+		if len(cvt.Timings) == 0 {
+			*warnings = append(*warnings, fmt.Sprintf("CVT 3-byte code %d does not support any refresh rate", i))
+		}
+		codes = append(codes, cvt)
 	}
-	if et[2]&ESTABLISHED_TIMINGS_1152x870_75Hz != 0 {
-		fmt.Println("\t1152x870 @ 75Hz")
+	return codes
+}
+
+func parseWhitePoints(dd [DISPLAY_DESCRIPTOR_SIZE]byte) []WhitePoint {
+	var points []WhitePoint
+	for i := 0; i < WHITE_POINT_COUNT; i++ {
+		wp := dd[WHITE_POINT_OFFSET+i*WHITE_POINT_SIZE : WHITE_POINT_OFFSET+(i+1)*WHITE_POINT_SIZE]
+		// Index 0 marks an unused white point
+		if wp[0] == 0x00 {
+			continue
+		}
+		point := WhitePoint{
+			Index: int(wp[0]),
+			X:     float64(int((wp[1]&WHITE_POINT_X_LSB)>>2)+int(wp[2])<<2) / 1024.0,
+			Y:     float64(int(wp[1]&WHITE_POINT_Y_LSB)+int(wp[3])<<2) / 1024.0,
+		}
+		if wp[4] != WHITE_POINT_GAMMA_UNDEFINED {
+			point.Gamma = float64(int(wp[4])+100) / 100.0
+		}
+		points = append(points, point)
 	}
+	return points
+}
+
+func parseColorManagement(dd [DISPLAY_DESCRIPTOR_SIZE]byte, warnings *[]string) *ColorManagement {
+	dcm := &ColorManagement{Version: int(dd[DCM_VERSION_OFFSET])}
+	if dd[DCM_VERSION_OFFSET] != DCM_VERSION {
+		*warnings = append(*warnings, fmt.Sprintf("Unknown color management data version: %d", dcm.Version))
+		return dcm
+	}
+	// Coefficients are little endian and stored multiplied by 100
+	coefficients := make([]float64, 6)
+	for i := range coefficients {
+		offset := DCM_OFFSET + i*2
+		coefficients[i] = float64(binary.LittleEndian.Uint16(dd[offset:offset+2])) / 100.0
+	}
+	dcm.RedA3, dcm.RedA2 = coefficients[0], coefficients[1]
+	dcm.GreenA3, dcm.GreenA2 = coefficients[2], coefficients[3]
+	dcm.BlueA3, dcm.BlueA2 = coefficients[4], coefficients[5]
+	return dcm
 }
 
 func aspectRatioByteToString(ar byte) string {
@@ -236,105 +345,78 @@ func aspectRatioToFloat(ar byte) float64 {
 	}
 }
 
-func parseStandardTimings(st [STANDARD_TIMINGS_COUNT][STANDARD_TIMINGS_SIZE]byte) {
-	for i := 0; i < STANDARD_TIMINGS_COUNT; i++ {
-		if st[i][0] == 0x01 && st[i][1] == 0x01 {
-			fmt.Println("\tStandard Timing ", i, ": Unused")
-			continue
-		}
-		horizontalActive := (int(st[i][0]) + 31) * 8
-		aspectRatio := (st[i][1] & 0xC0) >> 6
-		verticalActive := int((float64(horizontalActive) / aspectRatioToFloat(aspectRatio)))
-
-		verticalFrequency := (st[i][1] & 0x3F) + 60
-		fmt.Printf("\tStandard Timing %d: %d x %d @ %dHz (%s)\n", i, horizontalActive, verticalActive, verticalFrequency, aspectRatioByteToString(aspectRatio))
+func parseStandardTiming(st [STANDARD_TIMINGS_SIZE]byte) StandardTiming {
+	if st[0] == 0x01 && st[1] == 0x01 {
+		return StandardTiming{Unused: true}
+	}
+	horizontalActive := (int(st[0]) + 31) * 8
+	aspectRatio := (st[1] & 0xC0) >> 6
+	return StandardTiming{
+		HorizontalActive: horizontalActive,
+		VerticalActive:   int((float64(horizontalActive) / aspectRatioToFloat(aspectRatio))),
+		RefreshRate:      int(st[1]&0x3F) + 60,
+		AspectRatio:      aspectRatioByteToString(aspectRatio),
 	}
 }
 
-func parseDisplayDescriptorFeatures(fd byte) {
-	interlaced := (fd & FD_INTERLACED) >> 7
-	if interlaced == 0x01 {
-		fmt.Println("\t\t\tSignal type: Interlaced")
-	} else {
-		fmt.Println("\t\t\tSignal type: Progressive")
+func parseStandardTimings(st [STANDARD_TIMINGS_COUNT][STANDARD_TIMINGS_SIZE]byte) []StandardTiming {
+	timings := make([]StandardTiming, 0, STANDARD_TIMINGS_COUNT)
+	for i := 0; i < STANDARD_TIMINGS_COUNT; i++ {
+		timings = append(timings, parseStandardTiming(st[i]))
 	}
+	return timings
+}
+
+func parseStandardTimingIdentification(dd [DISPLAY_DESCRIPTOR_SIZE]byte) []StandardTiming {
+	timings := make([]StandardTiming, 0, STANDARD_TIMING_ID_COUNT)
+	for i := 0; i < STANDARD_TIMING_ID_COUNT; i++ {
+		var st [STANDARD_TIMINGS_SIZE]byte
+		copy(st[:], dd[STANDARD_TIMING_ID_OFFSET+i*STANDARD_TIMINGS_SIZE:])
+		timings = append(timings, parseStandardTiming(st))
+	}
+	return timings
+}
+
+func parseDisplayDescriptorFeatures(fd byte, timing *Timing) DetailedTimingFeatures {
+	var features DetailedTimingFeatures
+	timing.Interlaced = (fd&FD_INTERLACED)>>7 == 0x01
 	stereoMode := (fd&FD_STEREO)>>4 | (fd & FD_STEREO_MODE)
 	switch stereoMode {
 	case 0x00, 0x01:
-		fmt.Println("\t\t\tNo stereo")
+		features.Stereo = "No stereo"
 	case 0x02:
-		fmt.Println("\t\t\tField sequential stereo, right image when stereo sync signal is high")
+		features.Stereo = "Field sequential stereo, right image when stereo sync signal is high"
 	case 0x03:
-		fmt.Println("\t\t\tTwo way interleaved stereo, right image on even lines")
+		features.Stereo = "Two way interleaved stereo, right image on even lines"
 	case 0x04:
-		fmt.Println("\t\t\tField sequential stereo, left image when stereo sync signal is high")
+		features.Stereo = "Field sequential stereo, left image when stereo sync signal is high"
 	case 0x05:
-		fmt.Println("\t\t\tTwo way interleaved stereo, left image on even lines")
+		features.Stereo = "Two way interleaved stereo, left image on even lines"
 	case 0x06:
-		fmt.Println("\t\t\tFour way interleaved stereo")
+		features.Stereo = "Four way interleaved stereo"
 	case 0x07:
-		fmt.Println("\t\t\tSide by side interleaved stereo")
+		features.Stereo = "Side by side interleaved stereo"
 	default:
-		fmt.Println("\t\t\tReserved")
-	}
-	digitalSync := (fd & FD_DIGITAL_ANALOG_SYNC) >> 4
-	if digitalSync == 0x01 {
-		fmt.Println("\t\t\tDigital sync")
-
-		digitalCompositeSync := (fd & FD_DIGITAL_COMPOSITE_SYNC) >> 3
-		if digitalCompositeSync == 0x00 {
-			fmt.Println("\t\t\tDigital composite sync")
-			digitalSerrated := (fd & FD_DIGITAL_SERRATION) >> 2
-			if digitalSerrated == 0x01 {
-				fmt.Println("\t\t\tDigital serrated vsync")
-			} else {
-				fmt.Println("\t\t\tDigital vsync not serrated")
-			}
-			digitalHSyncPolarity := (fd & FD_DIGITAL_HSYNC_POLARITY) >> 1
-			if digitalHSyncPolarity == 0x01 {
-				fmt.Println("\t\t\tDigital HSync positive")
-			} else {
-				fmt.Println("\t\t\tDigital HSync negative")
-			}
+		features.Stereo = "Reserved"
+	}
+	features.DigitalSync = (fd&FD_DIGITAL_ANALOG_SYNC)>>4 == 0x01
+	if features.DigitalSync {
+		features.SeparateSync = (fd&FD_DIGITAL_COMPOSITE_SYNC)>>3 == 0x01
+		if features.SeparateSync {
+			timing.VerticalSyncPositive = (fd&FD_DIGITAL_VSYNC_POLARITY)>>2 == 0x01
 		} else {
-			fmt.Println("\t\t\tDigital separate sync")
-			digitalVsyncPolarity := (fd & FD_DIGITAL_VSYNC_POLARITY) >> 2
-			if digitalVsyncPolarity == 0x01 {
-				fmt.Println("\t\t\tDigital VSync positive")
-			} else {
-				fmt.Println("\t\t\tDigital VSync negative")
-			}
-			digitalHSyncPolarity := (fd & FD_DIGITAL_HSYNC_POLARITY) >> 1
-			if digitalHSyncPolarity == 0x01 {
-				fmt.Println("\t\t\tDigital HSync positive")
-			} else {
-				fmt.Println("\t\t\tDigital HSync negative")
-			}
+			features.Serrated = (fd&FD_DIGITAL_SERRATION)>>2 == 0x01
 		}
+		timing.HorizontalSyncPositive = (fd&FD_DIGITAL_HSYNC_POLARITY)>>1 == 0x01
 	} else {
-		fmt.Println("\t\t\tAnalog sync")
-		analogSync := (fd & FD_ANALOG_SYNC) >> 3
-		if analogSync == 0x01 {
-			fmt.Println("\t\t\tAnalog bipolar composite sync ")
-		} else {
-			fmt.Println("\t\t\tAnalog composite sync")
-		}
-		analogSerratedVsync := (fd & FD_ANALOG_SERRATED_VSYNC) >> 2
-		if analogSerratedVsync == 0x01 {
-			fmt.Println("\t\t\tAnalog serrated vsync")
-		} else {
-			fmt.Println("\t\t\tAnalog vsync not serrated")
-		}
-		analogSyncOnGreen := (fd & FD_ANALOG_SYNC_ON_GREEN) >> 1
-		if analogSyncOnGreen == 0x01 {
-			fmt.Println("\t\t\tAnalog sync on all RGB signals")
-		} else {
-			fmt.Println("\t\t\tAnalog sync on green")
-		}
+		features.BipolarSync = (fd&FD_ANALOG_SYNC)>>3 == 0x01
+		features.Serrated = (fd&FD_ANALOG_SERRATED_VSYNC)>>2 == 0x01
+		features.SyncOnAllRGB = (fd&FD_ANALOG_SYNC_ON_GREEN)>>1 == 0x01
 	}
+	return features
 }
 
-func parseDisplayTimingDescriptor(dd [DISPLAY_DESCRIPTOR_SIZE]byte) {
+func parseDisplayTimingDescriptor(dd [DISPLAY_DESCRIPTOR_SIZE]byte) DetailedTimingDescriptor {
 	var dtd DTD
 	copy(dtd.pixelClock[:], dd[0:2])
 	dtd.horizontalActiveLSB = dd[2]
@@ -353,112 +435,157 @@ func parseDisplayTimingDescriptor(dd [DISPLAY_DESCRIPTOR_SIZE]byte) {
 	dtd.horizontalBorder = dd[15]
 	dtd.verticalBorder = dd[16]
 	dtd.features = dd[17]
-	fmt.Printf("\t\tPixel Clock: %f MHz\n", float64(binary.LittleEndian.Uint16([]byte(dtd.pixelClock[:])))/100.0)
-	horizontalActive := (int(dtd.horizontalMSB&0xf0) << 4) | int(dtd.horizontalActiveLSB)
-	blanking := (int(dtd.horizontalMSB&0x0f) << 8) | int(dtd.horizontalBlankingLSB)
-	verticalActive := (int(dtd.verticalMSB&0xf0) << 4) | int(dtd.verticalActiveLSB)
-	verticalBlanking := (int(dtd.verticalMSB&0x0f) << 8) | int(dtd.verticalBlankingLSB)
-	horizontalFrontPorch := (int(dtd.horizontalVerticalMSB&0xC0) << 2) | (int(dtd.horizontalFrontPorchLSB))
-	horizontalSyncPulse := (int(dtd.horizontalVerticalMSB&0x30) << 4) | (int(dtd.horizontalSyncPulseLSB))
-	verticalFrontPorch := (int(dtd.horizontalVerticalMSB&0x0c) << 2) | (int(dtd.verticalFrontPorchSyncPulseLSB&0xF0) >> 4)
-	verticalSyncPulse := (int(dtd.horizontalVerticalMSB&0x03) << 4) | (int(dtd.verticalFrontPorchSyncPulseLSB & 0x0F))
-	horizontalImageSize := (int(dtd.sizeMSB&0xF0) << 4) | (int(dtd.horizontalImageSize))
-	verticalImageSize := (int(dtd.sizeMSB&0x0F) << 8) | (int(dtd.verticalImageSize))
-
-	fmt.Printf("\t\tHorizontal Active: %d\n", horizontalActive)
-	fmt.Printf("\t\tVertical Active: %d\n", verticalActive)
-	fmt.Printf("\t\tHorizontal Blanking: %d", blanking)
-	fmt.Printf(" Front Porch: %d", horizontalFrontPorch)
-	fmt.Printf(" Sync Pulse: %d\n", horizontalSyncPulse)
-	fmt.Printf("\t\tVertical Blanking: %d", verticalBlanking)
-	fmt.Printf(" Front Porch: %d", verticalFrontPorch)
-	fmt.Printf(" Sync Pulse: %d\n", verticalSyncPulse)
-	fmt.Printf("\t\tImage Size: %dmm x %dmm\n", horizontalImageSize, verticalImageSize)
-	fmt.Printf("\t\tHorizontal Border: %d\n", dtd.horizontalBorder)
-	fmt.Printf("\t\tVertical Border: %d\n", dtd.verticalBorder)
-	fmt.Printf("\t\tFeatures:\n")
-	parseDisplayDescriptorFeatures(dtd.features)
 
+	var desc DetailedTimingDescriptor
+	desc.PixelClock = float64(binary.LittleEndian.Uint16([]byte(dtd.pixelClock[:]))) / 100.0
+	desc.HorizontalActive = (int(dtd.horizontalMSB&0xf0) << 4) | int(dtd.horizontalActiveLSB)
+	desc.HorizontalBlanking = (int(dtd.horizontalMSB&0x0f) << 8) | int(dtd.horizontalBlankingLSB)
+	desc.VerticalActive = (int(dtd.verticalMSB&0xf0) << 4) | int(dtd.verticalActiveLSB)
+	desc.VerticalBlanking = (int(dtd.verticalMSB&0x0f) << 8) | int(dtd.verticalBlankingLSB)
+	desc.HorizontalFrontPorch = (int(dtd.horizontalVerticalMSB&0xC0) << 2) | (int(dtd.horizontalFrontPorchLSB))
+	desc.HorizontalSyncWidth = (int(dtd.horizontalVerticalMSB&0x30) << 4) | (int(dtd.horizontalSyncPulseLSB))
+	desc.VerticalFrontPorch = (int(dtd.horizontalVerticalMSB&0x0c) << 2) | (int(dtd.verticalFrontPorchSyncPulseLSB&0xF0) >> 4)
+	desc.VerticalSyncWidth = (int(dtd.horizontalVerticalMSB&0x03) << 4) | (int(dtd.verticalFrontPorchSyncPulseLSB & 0x0F))
+	desc.ImageWidth = (int(dtd.sizeMSB&0xF0) << 4) | (int(dtd.horizontalImageSize))
+	desc.ImageHeight = (int(dtd.sizeMSB&0x0F) << 8) | (int(dtd.verticalImageSize))
+	desc.HorizontalBorder = int(dtd.horizontalBorder)
+	desc.VerticalBorder = int(dtd.verticalBorder)
+	desc.Features = parseDisplayDescriptorFeatures(dtd.features, &desc.Timing)
+	desc.RefreshRate = desc.Timing.refreshRate()
+	return desc
 }
 
-func parseDisplayRangeLimitDescriptor(drd [DISPLAY_DESCRIPTOR_SIZE]byte) {
+func parseDisplayRangeLimitDescriptor(drd [DISPLAY_DESCRIPTOR_SIZE]byte) RangeLimits {
+	var limits RangeLimits
 	horizontRateOffset := (drd[4] & 0x0C) >> 2
 	verticalRateOffset := (drd[4] & 0x03)
-	verticalFieldRateMin := int(drd[5])
-	verticalFieldRateMax := int(drd[6])
+	limits.VerticalRateMin = int(drd[5])
+	limits.VerticalRateMax = int(drd[6])
 	if verticalRateOffset == 0x3 {
-		verticalFieldRateMin += 255
-		verticalFieldRateMax += 255
+		limits.VerticalRateMin += 255
+		limits.VerticalRateMax += 255
 	} else if verticalRateOffset == 0x2 {
-		verticalFieldRateMax += 255
+		limits.VerticalRateMax += 255
 	}
-	horizontalLineRateMin := int(drd[7])
-	horizontalLineRateMax := int(drd[8])
+	limits.HorizontalRateMin = int(drd[7])
+	limits.HorizontalRateMax = int(drd[8])
 	if horizontRateOffset == 0x3 {
-		horizontalLineRateMin += 255
-		horizontalLineRateMax += 255
+		limits.HorizontalRateMin += 255
+		limits.HorizontalRateMax += 255
 	} else if horizontRateOffset == 0x2 {
-		horizontalLineRateMax += 255
+		limits.HorizontalRateMax += 255
+	}
+	limits.MaxPixelClock = int(drd[9]) * 10
+	limits.ExtendedTimingType = drd[10]
+	copy(limits.VideoTimingParameters[:], drd[11:18])
+	switch limits.ExtendedTimingType {
+	case RANGE_LIMITS_SECONDARY_GTF:
+		limits.SecondaryGTF = parseSecondaryGTF(drd[11:18])
+	case RANGE_LIMITS_CVT:
+		limits.CVT = parseCVTSupport(drd[11:18], limits.MaxPixelClock)
+	}
+	return limits
+}
+
+func parseSecondaryGTF(params []byte) *SecondaryGTF {
+	return &SecondaryGTF{
+		StartFrequency: int(params[1]) * 2,
+		C:              float64(params[2]) / 2.0,
+		M:              int(binary.LittleEndian.Uint16(params[3:5])),
+		K:              int(params[5]),
+		J:              float64(params[6]) / 2.0,
 	}
-	maxPixelClock := int(drd[9]) * 10
-	extendedTimingType := drd[10]
+}
 
-	videoTimingParameters := drd[11:18]
+var cvtAspectRatios = []string{"4:3", "16:9", "16:10", "5:4", "15:9"}
 
-	fmt.Printf("\t\tVertical Field Rate: %d - %d Hz\n", verticalFieldRateMin, verticalFieldRateMax)
-	fmt.Printf("\t\tHorizontal Line Rate: %d - %d kHz\n", horizontalLineRateMin, horizontalLineRateMax)
-	fmt.Printf("\t\tMax Pixel Clock: %d MHz\n", maxPixelClock)
-	switch extendedTimingType {
-	case 0x00:
-		fmt.Println("\t\tDefault GTF")
-	case 0x01:
-		fmt.Println("\t\tNo timing information")
-	case 0x02:
-		fmt.Println("\t\tSecondary GTF supported")
-	case 0x03:
-		fmt.Println("\t\tCVT supported")
-	default:
-		fmt.Println("\t\tReserved")
+func parseCVTSupport(params []byte, maxPixelClock int) *CVTSupport {
+	var cvt CVTSupport
+	cvt.Version = fmt.Sprintf("%d.%d", params[0]>>4, params[0]&0x0F)
+	cvt.MaxPixelClock = float64(maxPixelClock) - float64((params[1]&RANGE_LIMITS_CVT_PRECISION_MASK)>>2)*0.25
+	cvt.MaxActivePixels = (int(params[1]&RANGE_LIMITS_CVT_ACTIVE_MSB)<<8 | int(params[2])) * 8
+
+	// Supported aspect ratios are flagged from bit 7 down in table order
+	for i, ar := range cvtAspectRatios {
+		if params[3]&(0x80>>i) != 0 {
+			cvt.AspectRatios = append(cvt.AspectRatios, ar)
+		}
 	}
-	fmt.Println("\t\tVideo Timing Parameters: ", videoTimingParameters)
+	preferred := int(params[4]&RANGE_LIMITS_CVT_ASPECT_MASK) >> 5
+	if preferred < len(cvtAspectRatios) {
+		cvt.PreferredAspectRatio = cvtAspectRatios[preferred]
+	} else {
+		cvt.PreferredAspectRatio = fmt.Sprintf("Reserved (%d)", preferred)
+	}
+	cvt.ReducedBlanking = params[4]&RANGE_LIMITS_CVT_RB != 0
+	cvt.StandardBlanking = params[4]&RANGE_LIMITS_CVT_STANDARD != 0
+
+	if params[5]&RANGE_LIMITS_CVT_H_SHRINK != 0 {
+		cvt.Scaling = append(cvt.Scaling, "Horizontal shrink")
+	}
+	if params[5]&RANGE_LIMITS_CVT_H_STRETCH != 0 {
+		cvt.Scaling = append(cvt.Scaling, "Horizontal stretch")
+	}
+	if params[5]&RANGE_LIMITS_CVT_V_SHRINK != 0 {
+		cvt.Scaling = append(cvt.Scaling, "Vertical shrink")
+	}
+	if params[5]&RANGE_LIMITS_CVT_V_STRETCH != 0 {
+		cvt.Scaling = append(cvt.Scaling, "Vertical stretch")
+	}
+	cvt.PreferredRefreshRate = int(params[6])
+	return &cvt
+}
+
+func descriptorText(dd [DISPLAY_DESCRIPTOR_SIZE]byte) string {
+	return strings.Replace(string(dd[5:]), "\n", "", -1)
 }
 
-func parseDisplayDescriptor(dd [DISPLAY_DESCRIPTOR_COUNT][DISPLAY_DESCRIPTOR_SIZE]byte) {
+func parseDisplayDescriptor(dd [DISPLAY_DESCRIPTOR_COUNT][DISPLAY_DESCRIPTOR_SIZE]byte, warnings *[]string) []DisplayDescriptor {
+	descriptors := make([]DisplayDescriptor, 0, DISPLAY_DESCRIPTOR_COUNT)
 	for i := 0; i < DISPLAY_DESCRIPTOR_COUNT; i++ {
-		if dd[i][0] != 0x00 && dd[i][1] != 0x00 {
-			fmt.Printf("\tDisplay Descriptor %d\n", i)
-			parseDisplayTimingDescriptor(dd[i])
+		descriptor := DisplayDescriptor{Index: i}
+		// Display descriptors start with a zero pixel clock, a detailed
+		// timing may still have a zero low byte (e.g. 0x1D00, 74.24 MHz)
+		if dd[i][0] != 0x00 || dd[i][1] != 0x00 {
+			dtd := parseDisplayTimingDescriptor(dd[i])
+			descriptor.DetailedTiming = &dtd
 		} else {
-			descriptorType := dd[i][3]
-			switch descriptorType {
-			case DTD_TYPE_MANUFACTURER_SPECIFIC:
-				fmt.Println("\tDisplay Descriptor ", i, ": Manufacturer specific: ", strings.Replace(string(dd[i][5:]), "\n", "", -1))
-			case DTD_TYPE_MONITOR_SERIAL_NUMBER:
-				fmt.Println("\tDisplay Descriptor ", i, ": Monitor serial number: ", strings.Replace(string(dd[i][5:]), "\n", "", -1))
-			case DTD_TYPE_UNSPECIFIED:
-				fmt.Println("\tDisplay Descriptor ", i, ": Unspecified")
+			descriptor.Type = dd[i][3]
+			switch descriptor.Type {
+			case DTD_TYPE_MANUFACTURER_SPECIFIC, DTD_TYPE_MONITOR_SERIAL_NUMBER, DTD_TYPE_MONITOR_NAME:
+				descriptor.Text = descriptorText(dd[i])
 			case DTD_TYPE_RANGE_LIMITS:
-				fmt.Println("\tDisplay Descriptor ", i, ": Range limits")
-				parseDisplayRangeLimitDescriptor(dd[i])
-			case DTD_TYPE_MONITOR_NAME:
-				fmt.Println("\tDisplay Descriptor ", i, ": Monitor name: ", strings.Replace(string(dd[i][5:]), "\n", "", -1))
-			case DTD_TYPE_WHITE_POINT_DATA:
-				fmt.Println("\tDisplay Descriptor ", i, ": White point data")
+				limits := parseDisplayRangeLimitDescriptor(dd[i])
+				descriptor.RangeLimits = &limits
+			case DTD_TYPE_ADDITIONAL_STANDARD_TIMING:
+				descriptor.EstablishedTimings = parseEstablishedTimingsIII(dd[i], warnings)
 			case DTD_TYPE_STANDARD_TIMING_IDENTIFICATION:
-				fmt.Println("\tDisplay Descriptor ", i, ": Standard timing identification")
-			case DTD_TYPE_COLOR_POINT_DATA:
-				fmt.Println("\tDisplay Descriptor ", i, ": Color point data")
+				descriptor.StandardTimings = parseStandardTimingIdentification(dd[i])
 			case DTD_TYPE_CVT_3_BYTE_CODE:
-				fmt.Println("\tDisplay Descriptor ", i, ": CVT 3-byte code")
-			case DTD_TYPE_ADDITIONAL_STANDARD_TIMING:
-				fmt.Println("\tDisplay Descriptor ", i, ": Additional standard timing")
-			case DTD_TYPE_DUMMY:
-				fmt.Println("\tDisplay Descriptor ", i, ": Dummy")
-			default:
-				fmt.Println("\tDisplay Descriptor ", i, ": Reserved")
+				descriptor.CVTCodes = parseCVTCodes(dd[i], warnings)
+			case DTD_TYPE_WHITE_POINT_DATA:
+				descriptor.WhitePoints = parseWhitePoints(dd[i])
+			case DTD_TYPE_COLOR_POINT_DATA:
+				descriptor.ColorManagement = parseColorManagement(dd[i], warnings)
 			}
 		}
+		descriptors = append(descriptors, descriptor)
 	}
+	return descriptors
+}
+
+func (t Timing) refreshRate() float64 {
+	vTotal := float64(t.VerticalActive + t.VerticalBlanking)
+	// Interlaced vertical timings describe a single field, each frame
+	// carries an extra half line per field
+	if t.Interlaced {
+		vTotal += 0.5
+	}
+	total := float64(t.HorizontalActive+t.HorizontalBlanking) * vTotal
+	if total == 0 {
+		return 0
+	}
+	return t.PixelClock * 1000 * 1000 / total
 }
 
 func (edid EDID) Checksum() bool {
@@ -469,39 +596,72 @@ func (edid EDID) Checksum() bool {
 	return (int(sum)+int(edid.checksum) == 256)
 }
 
-func (edid EDID) Parse() ([]string, error) {
-	warnings := make([]string, 0)
+// Checksum returns the expected checksum of any extension block type
+func (block *ExtensionBlock) Checksum() int {
+	return int(generateChecksum(block.data[:EXTENSION_SIZE-CHECKSUM_SIZE]))
+}
+
+func decodeExtension(extBlock *ExtensionBlock, warnings *[]string) (Extension, error) {
+	var err error
+	ext := Extension{Tag: extBlock.tag}
+	switch extBlock.tag {
+	case EXTENSION_TAG_CTA:
+		ext.CTA, err = decodeCTAExtension(extBlock, warnings)
+	case EXTENSION_TAG_DISPLAYID:
+		ext.DisplayID, err = decodeDisplayIDExtension(extBlock, warnings)
+	case EXTENSION_TAG_BLOCK_MAP:
+	default:
+		*warnings = append(*warnings, fmt.Sprintf("Unsupported extension block tag 0x%02x", extBlock.tag))
+	}
+	ext.Checksum = extBlock.data[EXTENSION_SIZE-CHECKSUM_SIZE]
+	ext.ChecksumValid = extBlock.Checksum() == int(ext.Checksum)
+	return ext, err
+}
+
+// Decode decodes the base block and every extension block into a DecodedEDID.
+func (edid EDID) Decode() (DecodedEDID, error) {
+	var decoded DecodedEDID
+	decoded.Warnings = make([]string, 0)
+
 	var manId [3]byte
 	manId[0] = manIdByteToChar((edid.manufacturerId[0] >> 2) & 0x1F)
 	manId[1] = manIdByteToChar(((edid.manufacturerId[0] & 0x3) << 3) | ((edid.manufacturerId[1] & 0xE0) >> 5))
 	manId[2] = manIdByteToChar(edid.manufacturerId[1] & 0x1F)
+	decoded.ManufacturerID = string(manId[:])
+	decoded.ProductCode = binary.LittleEndian.Uint16([]byte(edid.productCode[:]))
+	decoded.SerialNumber = binary.LittleEndian.Uint32([]byte(edid.serialNumber[:]))
+	decoded.WeekOfManufacture = int(edid.weekOfManufacture)
+	decoded.YearOfManufacture = int(edid.yearOfManufacture) + 1990
+	decoded.Version = int(edid.edidVersion)
+	decoded.Revision = int(edid.edidRevision)
 
-	fmt.Println("Manufacturer ID: ", string(manId[:]))
-	fmt.Printf("Product Code: %d\n", binary.LittleEndian.Uint16([]byte(edid.productCode[:])))
-	fmt.Printf("Serial Number: %d\n", binary.LittleEndian.Uint32([]byte(edid.serialNumber[:])))
-	fmt.Printf("Week of Manufacture: %d\n", edid.weekOfManufacture)
-	fmt.Printf("Year of Manufacture: %d\n", int(edid.yearOfManufacture)+1990)
-	fmt.Printf("EDID Version: %d.%d\n", edid.edidVersion, edid.edidRevision)
-
-	fmt.Printf("Basic Display Parameters:\n")
-	parseBDP(edid.basicDisplayParameters[:])
+	decoded.BasicDisplayParameters = parseBDP(edid.basicDisplayParameters[:])
+	decoded.ChromaticityCoordinates = parseChromaticityCoordinates(edid.chromaticityCoordinates[:])
+	decoded.EstablishedTimings = parseEstablishedTimings(edid.establishedTimings[:])
+	decoded.StandardTimings = parseStandardTimings(edid.standardTimings)
+	decoded.DisplayDescriptors = parseDisplayDescriptor(edid.displayDescriptor, &decoded.Warnings)
 
-	fmt.Printf("Chromaticity Coordinates:\n")
-	parseChromaticityCoordinates(edid.chromaticityCoordinates[:])
+	decoded.ExtensionFlag = int(edid.extensionFlag)
+	decoded.Checksum = edid.checksum
+	decoded.ChecksumValid = edid.Checksum()
 
-	fmt.Printf("Established Timings:\n")
-	parseEstablishedTimings(edid.establishedTimings[:])
-
-	fmt.Printf("Standard Timings:\n")
-	parseStandardTimings(edid.standardTimings)
-
-	fmt.Printf("Display Timing Descriptor:\n")
-	parseDisplayDescriptor(edid.displayDescriptor)
-
-	fmt.Printf("Extension Flag: 0x%02X\n", edid.extensionFlag)
-	fmt.Printf("Checksum: 0x%02X\n", edid.checksum)
-	fmt.Printf("Checksum Valid: %t\n", edid.Checksum())
+	for i := range edid.extensions {
+		ext, err := decodeExtension(&edid.extensions[i], &decoded.Warnings)
+		if err != nil {
+			return decoded, err
+		}
+		ext.Index = i + 1
+		decoded.Extensions = append(decoded.Extensions, ext)
+	}
+	decoded.Modes = buildModeList(&decoded)
+	return decoded, nil
+}
 
-	edid.ParseCTA(&warnings)
-	return warnings, nil
+func (edid EDID) Parse() ([]string, error) {
+	decoded, err := edid.Decode()
+	if err != nil {
+		return decoded.Warnings, err
+	}
+	decoded.Print()
+	return decoded.Warnings, nil
 }
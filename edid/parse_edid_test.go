@@ -0,0 +1,71 @@
+package edid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDisplayRangeLimitDescriptorCVT(t *testing.T) {
+	drd := [DISPLAY_DESCRIPTOR_SIZE]byte{
+		0x00, 0x00, 0x00, 0xFD, 0x00,
+		56, 76, 30, 83, 17,
+		RANGE_LIMITS_CVT, 0x11, 0x0C, 0xF0, 0x60, 0x38, 0x50, 60,
+	}
+	limits := parseDisplayRangeLimitDescriptor(drd)
+	if limits.CVT == nil {
+		t.Fatal("CVT parameters missing")
+	}
+	want := CVTSupport{
+		Version:              "1.1",
+		MaxPixelClock:        169.25,
+		MaxActivePixels:      1920,
+		AspectRatios:         []string{"16:9", "16:10"},
+		PreferredAspectRatio: "16:9",
+		ReducedBlanking:      true,
+		StandardBlanking:     true,
+		Scaling:              []string{"Horizontal stretch", "Vertical stretch"},
+		PreferredRefreshRate: 60,
+	}
+	if !reflect.DeepEqual(*limits.CVT, want) {
+		t.Errorf("got %+v\nwant %+v", *limits.CVT, want)
+	}
+	if name := rangeLimitsTimingName(limits.ExtendedTimingType); name != "CVT supported" {
+		t.Errorf("timing type 0x%02x reported as %q", limits.ExtendedTimingType, name)
+	}
+}
+
+func TestParseDisplayRangeLimitDescriptorReserved(t *testing.T) {
+	// 0x03 is reserved in EDID 1.4, CVT uses 0x04
+	drd := [DISPLAY_DESCRIPTOR_SIZE]byte{
+		0x00, 0x00, 0x00, 0xFD, 0x00,
+		56, 76, 30, 83, 17,
+		0x03, 0x11, 0x0C, 0xF0, 0x60, 0x38, 0x50, 60,
+	}
+	limits := parseDisplayRangeLimitDescriptor(drd)
+	if limits.CVT != nil || limits.SecondaryGTF != nil {
+		t.Errorf("reserved timing type decoded as %+v", limits)
+	}
+	if name := rangeLimitsTimingName(limits.ExtendedTimingType); name != "Reserved" {
+		t.Errorf("timing type 0x03 reported as %q, want Reserved", name)
+	}
+}
+
+func TestParseDisplayDescriptorZeroPixelClockLSB(t *testing.T) {
+	var dd [DISPLAY_DESCRIPTOR_COUNT][DISPLAY_DESCRIPTOR_SIZE]byte
+	// 1280x720p with a 74.24 MHz pixel clock, 0x1D00 in 10 kHz units
+	dd[0] = [DISPLAY_DESCRIPTOR_SIZE]byte{0x00, 0x1D, 0x00, 0x72, 0x51, 0xD0, 0x1E, 0x20, 0x6E, 0x28, 0x55, 0x00, 0x0F, 0x28, 0x21, 0x00, 0x00, 0x1E}
+	dd[1] = [DISPLAY_DESCRIPTOR_SIZE]byte{0x00, 0x00, 0x00, DTD_TYPE_DUMMY}
+	var warnings []string
+	descriptors := parseDisplayDescriptor(dd, &warnings)
+
+	dtd := descriptors[0].DetailedTiming
+	if dtd == nil {
+		t.Fatal("detailed timing with a zero pixel clock low byte decoded as a display descriptor")
+	}
+	if dtd.PixelClock != 74.24 || dtd.HorizontalActive != 1280 || dtd.VerticalActive != 720 {
+		t.Errorf("got %s with a %.2f MHz pixel clock, want 1280x720p with 74.24 MHz", timingString(dtd.Timing), dtd.PixelClock)
+	}
+	if descriptors[1].DetailedTiming != nil || descriptors[1].Type != DTD_TYPE_DUMMY {
+		t.Errorf("dummy descriptor decoded as %+v", descriptors[1])
+	}
+}
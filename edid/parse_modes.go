@@ -0,0 +1,137 @@
+package edid
+
+import (
+	"fmt"
+)
+
+func timingMode(timing Timing, native bool, source string) Mode {
+	mode := Mode{
+		HorizontalActive: timing.HorizontalActive,
+		VerticalActive:   timing.VerticalActive,
+		RefreshRate:      timing.RefreshRate,
+		Interlaced:       timing.Interlaced,
+		Native:           native,
+		Source:           source,
+		Timing:           &timing,
+	}
+	// Interlaced timings describe a single field
+	if timing.Interlaced {
+		mode.VerticalActive *= 2
+	}
+	return mode
+}
+
+// The CTA-861 native DTD count covers the DTDs of the base block followed
+// by those of the CTA extensions, in order of appearance
+func nativeDTDCount(decoded *DecodedEDID) int {
+	for _, ext := range decoded.Extensions {
+		if ext.CTA != nil {
+			return ext.CTA.NativeDTDs
+		}
+	}
+	return 0
+}
+
+func standardTimingModes(st []StandardTiming, suffix string) []Mode {
+	var modes []Mode
+	for i, t := range st {
+		if t.Unused {
+			continue
+		}
+		modes = append(modes, Mode{
+			HorizontalActive: t.HorizontalActive,
+			VerticalActive:   t.VerticalActive,
+			RefreshRate:      float64(t.RefreshRate),
+			Source:           fmt.Sprintf("Standard timing %d%s", i, suffix),
+		})
+	}
+	return modes
+}
+
+func buildModeList(decoded *DecodedEDID) []Mode {
+	modes := make([]Mode, 0)
+
+	for _, t := range decoded.EstablishedTimings {
+		modes = append(modes, Mode{
+			HorizontalActive: t.HorizontalActive,
+			VerticalActive:   t.VerticalActive,
+			RefreshRate:      float64(t.RefreshRate),
+			Source:           "Established timing",
+		})
+	}
+	for _, descriptor := range decoded.DisplayDescriptors {
+		for _, t := range descriptor.EstablishedTimings {
+			mode := Mode{
+				HorizontalActive: t.HorizontalActive,
+				VerticalActive:   t.VerticalActive,
+				RefreshRate:      float64(t.RefreshRate),
+				Source:           fmt.Sprintf("Established timing III, display descriptor %d", descriptor.Index),
+			}
+			if dmt, found := dmtByID(t.DMTID); found {
+				mode = timingMode(dmt.timing(), false, mode.Source)
+			}
+			modes = append(modes, mode)
+		}
+		suffix := fmt.Sprintf(", display descriptor %d", descriptor.Index)
+		modes = append(modes, standardTimingModes(descriptor.StandardTimings, suffix)...)
+		for i, cvt := range descriptor.CVTCodes {
+			for _, t := range cvt.Timings {
+				source := fmt.Sprintf("CVT 3-byte code %d, display descriptor %d", i, descriptor.Index)
+				modes = append(modes, timingMode(t.Timing, false, source))
+			}
+		}
+	}
+	modes = append(modes, standardTimingModes(decoded.StandardTimings, "")...)
+
+	// The first DTD of the base block is the preferred timing mode, the
+	// native DTD count of the CTA header marks the native ones
+	nativeDTDs := nativeDTDCount(decoded)
+	dtdIndex := 0
+	for _, descriptor := range decoded.DisplayDescriptors {
+		if descriptor.DetailedTiming == nil {
+			continue
+		}
+		source := fmt.Sprintf("Detailed timing descriptor %d", descriptor.Index)
+		mode := timingMode(descriptor.DetailedTiming.Timing, dtdIndex < nativeDTDs, source)
+		mode.Preferred = dtdIndex == 0
+		modes = append(modes, mode)
+		dtdIndex++
+	}
+
+	for _, ext := range decoded.Extensions {
+		switch {
+		case ext.CTA != nil:
+			for _, block := range ext.CTA.DataBlocks {
+				kind := "CTA-861 VIC"
+				if block.Tag == CTA_EXT_TAG_USE_EXTENDED_TAG && block.ExtendedTag == CTA_EXTENDED_TAG_YCBCR420_VIDEO_DATA_BLOCK {
+					kind = "CTA-861 YCbCr 4:2:0 only VIC"
+				}
+				for _, svd := range block.VideoDescriptors {
+					if svd.Timing == nil {
+						continue
+					}
+					source := fmt.Sprintf("Extension %d: %s %d", ext.Index, kind, svd.VIC)
+					modes = append(modes, timingMode(*svd.Timing, svd.Native, source))
+				}
+			}
+			for i, dtd := range ext.CTA.DetailedTimings {
+				native := dtdIndex < nativeDTDs
+				source := fmt.Sprintf("Extension %d: CTA-861 detailed timing descriptor %d", ext.Index, i)
+				modes = append(modes, timingMode(dtd.Timing, native, source))
+				dtdIndex++
+			}
+		case ext.DisplayID != nil:
+			for _, section := range ext.DisplayID.Sections {
+				for _, block := range section.Blocks {
+					for i, t := range block.Timings {
+						source := fmt.Sprintf("Extension %d: DisplayID block 0x%02x timing %d", ext.Index, block.Tag, i)
+						mode := timingMode(t.Timing, false, source)
+						mode.Preferred = t.Preferred
+						modes = append(modes, mode)
+					}
+				}
+			}
+		}
+	}
+	return modes
+}
@@ -0,0 +1,448 @@
+package edid
+
+import (
+	"fmt"
+)
+
+func ctaDataBlockName(block CTADataBlock) string {
+	switch block.Tag {
+	case CTA_EXT_TAG_AUDIO_DATA_BLOCK:
+		return "Audio data block"
+	case CTA_EXT_TAG_VIDEO_DATA_BLOCK:
+		return "Video data block"
+	case CTA_EXT_TAG_VENDOR_SPECIFIC_DATA_BLOCK:
+		return "Vendor-specific data block"
+	case CTA_EXT_TAG_SPEAKER_ALLOCATION_DATA_BLOCK:
+		return "Speaker allocation data block"
+	case CTA_EXT_TAG_VESA_DTC_DATA_BLOCK:
+		return "VESA DTC data block"
+	case CTA_EXT_TAG_VIDEO_FORMAT_DATA_BLOCK:
+		return "Video format data block"
+	case CTA_EXT_TAG_USE_EXTENDED_TAG:
+		return ctaExtendedDataBlockName(block.ExtendedTag)
+	default:
+		return "Reserved data block"
+	}
+}
+
+func ctaExtendedDataBlockName(extendedTag byte) string {
+	switch extendedTag {
+	case CTA_EXTENDED_TAG_VIDEO_CAPABILITY_DATA_BLOCK:
+		return "Video capability data block"
+	case CTA_EXTENDED_TAG_VENDOR_SPECIFIC_VIDEO_DATA_BLOCK:
+		return "Vendor-specific video data block"
+	case CTA_EXTENDED_TAG_VESA_DISPLAY_DEVICE_DATA_BLOCK:
+		return "VESA display device data block"
+	case CTA_EXTENDED_TAG_VESA_VIDEO_TIMING_DATA_BLOCK:
+		return "VESA video timing block extension"
+	case CTA_EXTENDED_TAG_COLORIMETRY_DATA_BLOCK:
+		return "Colorimetry data block"
+	case CTA_EXTENDED_TAG_HDR_STATIC_METADATA_DATA_BLOCK:
+		return "HDR static metadata data block"
+	case CTA_EXTENDED_TAG_HDR_DYNAMIC_METADATA_DATA_BLOCK:
+		return "HDR dynamic metadata data block"
+	case CTA_EXTENDED_TAG_NATIVE_VIDEO_RESOLUTION_DATA_BLOCK:
+		return "Native video resolution data block"
+	case CTA_EXTENDED_TAG_VIDEO_FORMAT_PREFERENCE_DATA_BLOCK:
+		return "Video format preference data block"
+	case CTA_EXTENDED_TAG_YCBCR420_VIDEO_DATA_BLOCK:
+		return "YCbCr 4:2:0 video data block"
+	case CTA_EXTENDED_TAG_YCBCR420_CAPABILITY_MAP_DATA_BLOCK:
+		return "YCbCr 4:2:0 capability map data block"
+	case CTA_EXTENDED_TAG_VENDOR_SPECIFIC_AUDIO_DATA_BLOCK:
+		return "Vendor-specific audio data block"
+	case CTA_EXTENDED_TAG_HDMI_AUDIO_DATA_BLOCK:
+		return "HDMI audio data block"
+	case CTA_EXTENDED_TAG_ROOM_CONFIGURATION_DATA_BLOCK:
+		return "Room configuration data block"
+	case CTA_EXTENDED_TAG_SPEAKER_LOCATION_DATA_BLOCK:
+		return "Speaker location data block"
+	case CTA_EXTENDED_TAG_INFOFRAME_DATA_BLOCK:
+		return "InfoFrame data block"
+	case CTA_EXTENDED_TAG_DISPLAYID_TYPE_VII_DATA_BLOCK:
+		return "DisplayID type VII video timing data block"
+	case CTA_EXTENDED_TAG_DISPLAYID_TYPE_VIII_DATA_BLOCK:
+		return "DisplayID type VIII video timing data block"
+	case CTA_EXTENDED_TAG_DISPLAYID_TYPE_X_DATA_BLOCK:
+		return "DisplayID type X video timing data block"
+	case CTA_EXTENDED_TAG_HF_EEODB_DATA_BLOCK:
+		return "HDMI Forum EDID extension override data block"
+	case CTA_EXTENDED_TAG_HF_SCDB_DATA_BLOCK:
+		return "HDMI Forum sink capability data block"
+	default:
+		return fmt.Sprintf("Unknown extended data block 0x%02x", extendedTag)
+	}
+}
+
+func printVideoDataBlock(svds []ShortVideoDescriptor) {
+	for _, svd := range svds {
+		native := ""
+		if svd.Native {
+			native = " (native)"
+		}
+		if svd.Timing == nil {
+			fmt.Printf("\t\tVIC %3d: Unknown%s\n", svd.VIC, native)
+			continue
+		}
+		ycbcr420 := ""
+		if svd.YCbCr420 {
+			ycbcr420 = " (also 4:2:0)"
+		}
+		fmt.Printf("\t\tVIC %3d: %s %s%s%s\n", svd.VIC, timingString(*svd.Timing), svd.AspectRatio, native, ycbcr420)
+	}
+}
+
+func printAudioDataBlock(sads []ShortAudioDescriptor) {
+	for _, sad := range sads {
+		fmt.Printf("\t\t%s:\n", sad.FormatName)
+		if sad.MaxChannels != 0 {
+			fmt.Printf("\t\t\tMax channels: %d\n", sad.MaxChannels)
+		}
+		fmt.Printf("\t\t\tSample rates:")
+		for _, rate := range sad.SampleRates {
+			fmt.Printf(" %g", float64(rate)/1000.0)
+		}
+		fmt.Printf(" kHz\n")
+		if len(sad.BitDepths) != 0 {
+			fmt.Printf("\t\t\tBit depths:")
+			for _, depth := range sad.BitDepths {
+				fmt.Printf(" %d", depth)
+			}
+			fmt.Printf(" bits\n")
+		}
+		if sad.MaxBitrate != 0 {
+			fmt.Printf("\t\t\tMax bitrate: %d kbit/s\n", sad.MaxBitrate)
+		}
+		for _, flag := range sad.Flags {
+			fmt.Printf("\t\t\t%s\n", flag)
+		}
+	}
+}
+
+func printSpeakers(speakers []string) {
+	for _, speaker := range speakers {
+		fmt.Printf("\t\t%s\n", speaker)
+	}
+}
+
+func printRoomConfiguration(room *RoomConfiguration) {
+	if room.SpeakerCount != 0 {
+		fmt.Printf("\t\tSpeaker count: %d\n", room.SpeakerCount)
+	}
+	if room.SpeakerLocationsPresent {
+		fmt.Println("\t\tSpeaker location descriptors are present")
+	}
+	printSpeakers(room.Speakers)
+	if room.MaxX != 0 || room.MaxY != 0 || room.MaxZ != 0 {
+		fmt.Printf("\t\tRoom dimensions: Xmax %d dm, Ymax %d dm, Zmax %d dm\n", room.MaxX, room.MaxY, room.MaxZ)
+	}
+	if room.DisplayPresent {
+		fmt.Printf("\t\tDisplay location: X %.3f * Xmax, Y %.3f * Ymax, Z %.3f * Zmax\n", room.DisplayX, room.DisplayY, room.DisplayZ)
+	}
+}
+
+func printSpeakerLocations(locations []SpeakerLocation) {
+	for _, location := range locations {
+		fmt.Printf("\t\tChannel %d: %s\n", location.ChannelIndex, location.Speaker)
+		fmt.Printf("\t\t\tActive: %t\n", location.Active)
+		if location.CoordinatesPresent {
+			fmt.Printf("\t\t\tLocation: X %.3f * Xmax, Y %.3f * Ymax, Z %.3f * Zmax\n", location.X, location.Y, location.Z)
+		}
+	}
+}
+
+func hdmiLatencyString(latency int) string {
+	switch latency {
+	case 0:
+		return "unknown"
+	case -1:
+		return "not supported"
+	default:
+		return fmt.Sprintf("%d ms", latency)
+	}
+}
+
+func printHDMIVSDB(vsdb *HDMIVSDB) {
+	fmt.Printf("\t\tPhysical address: %s\n", vsdb.PhysicalAddress)
+	if vsdb.SupportsAI {
+		fmt.Println("\t\tSupports_AI")
+	}
+	if vsdb.DeepColor48 {
+		fmt.Println("\t\tDC_48bit")
+	}
+	if vsdb.DeepColor36 {
+		fmt.Println("\t\tDC_36bit")
+	}
+	if vsdb.DeepColor30 {
+		fmt.Println("\t\tDC_30bit")
+	}
+	if vsdb.DeepColorY444 {
+		fmt.Println("\t\tDC_Y444")
+	}
+	if vsdb.DVIDual {
+		fmt.Println("\t\tDVI_Dual")
+	}
+	if vsdb.MaxTMDSClock != 0 {
+		fmt.Printf("\t\tMaximum TMDS clock: %d MHz\n", vsdb.MaxTMDSClock)
+	}
+	for _, contentType := range vsdb.ContentTypes {
+		fmt.Printf("\t\tSupported content type: %s\n", contentType)
+	}
+	if vsdb.LatencyPresent {
+		fmt.Printf("\t\tVideo latency: %s\n", hdmiLatencyString(vsdb.VideoLatency))
+		fmt.Printf("\t\tAudio latency: %s\n", hdmiLatencyString(vsdb.AudioLatency))
+	}
+	if vsdb.InterlacedLatency {
+		fmt.Printf("\t\tInterlaced video latency: %s\n", hdmiLatencyString(vsdb.InterlacedVideoLatency))
+		fmt.Printf("\t\tInterlaced audio latency: %s\n", hdmiLatencyString(vsdb.InterlacedAudioLatency))
+	}
+	if !vsdb.HDMIVideoPresent {
+		return
+	}
+	if vsdb.Present3D {
+		fmt.Println("\t\t3D present")
+	}
+	switch vsdb.ImageSize {
+	case 0x01:
+		fmt.Println("\t\tImage size: only the aspect ratio is correct")
+	case 0x02:
+		fmt.Println("\t\tImage size: correct to the nearest 1 cm")
+	case 0x03:
+		fmt.Println("\t\tImage size: correct to multiples of 5 cm")
+	}
+	for _, format := range vsdb.HDMIVICs {
+		if format.Timing == nil {
+			fmt.Printf("\t\tHDMI VIC %d: Unknown\n", format.HDMIVIC)
+			continue
+		}
+		fmt.Printf("\t\tHDMI VIC %d: %s (VIC %d)\n", format.HDMIVIC, timingString(*format.Timing), format.VIC)
+	}
+	if len(vsdb.Structures3D) != 0 {
+		if vsdb.Multi3D == 0x02 {
+			fmt.Printf("\t\t3D structures for SVDs in mask 0x%04x:\n", vsdb.Mask3D)
+		} else {
+			fmt.Println("\t\t3D structures for the first 16 SVDs:")
+		}
+		for _, structure := range vsdb.Structures3D {
+			fmt.Printf("\t\t\t%s\n", structure)
+		}
+	}
+	for _, entry := range vsdb.Entries3D {
+		if entry.Structure >= HDMI_3D_STRUCTURE_SBS_HALF {
+			fmt.Printf("\t\t3D structure for SVD %d: %s, detail %d\n", entry.SVDIndex, entry.Name, entry.Detail)
+			continue
+		}
+		fmt.Printf("\t\t3D structure for SVD %d: %s\n", entry.SVDIndex, entry.Name)
+	}
+}
+
+func printHDMIForumSCDS(hf *HDMIForumSCDS) {
+	fmt.Printf("\t\tVersion: %d\n", hf.Version)
+	if hf.MaxTMDSCharacterRate != 0 {
+		fmt.Printf("\t\tMaximum TMDS character rate: %d Mcsc\n", hf.MaxTMDSCharacterRate)
+	}
+	flags := []struct {
+		set  bool
+		name string
+	}{
+		{hf.SCDCPresent, "SCDC present"},
+		{hf.RRCapable, "SCDC read request capable"},
+		{hf.CableStatus, "Supports cable status"},
+		{hf.CCBPCI, "Supports color content bits per component indication"},
+		{hf.LTE340McscScramble, "Supports scrambling for <= 340 Mcsc"},
+		{hf.IndependentView3D, "Supports 3D independent view signaling"},
+		{hf.DualView3D, "Supports 3D dual view signaling"},
+		{hf.OSDDisparity3D, "Supports 3D OSD disparity indication"},
+		{hf.UHDVIC, "Supports UHD VICs"},
+		{hf.DeepColor48420, "Supports 16-bits/component deep color 4:2:0 pixel encoding"},
+		{hf.DeepColor36420, "Supports 12-bits/component deep color 4:2:0 pixel encoding"},
+		{hf.DeepColor30420, "Supports 10-bits/component deep color 4:2:0 pixel encoding"},
+		{hf.FAPAStartLocation, "Supports FAPA start location"},
+		{hf.ALLM, "Supports auto low-latency mode"},
+		{hf.FVA, "Supports fast vactive"},
+		{hf.CNMVRR, "Supports negative M_VRR values"},
+		{hf.CinemaVRR, "Supports cinema VRR"},
+		{hf.MDelta, "Supports M_Delta"},
+		{hf.QMS, "Supports QMS"},
+		{hf.FAPAEndExtended, "Supports FAPA end extended"},
+	}
+	for _, flag := range flags {
+		if flag.set {
+			fmt.Printf("\t\t%s\n", flag.name)
+		}
+	}
+	if hf.FRLLanes != 0 {
+		fmt.Printf("\t\tMaximum FRL rate: %d Gbps per lane on %d lanes\n", hf.FRLRate, hf.FRLLanes)
+	}
+	if hf.VRRMin != 0 {
+		fmt.Printf("\t\tMinimum refresh rate: %d Hz\n", hf.VRRMin)
+	}
+	if hf.VRRMax != 0 {
+		fmt.Printf("\t\tMaximum refresh rate: %d Hz\n", hf.VRRMax)
+	}
+	if !hf.DSC12 {
+		return
+	}
+	fmt.Println("\t\tSupports VESA DSC 1.2a compression")
+	dscFlags := []struct {
+		set  bool
+		name string
+	}{
+		{hf.DSCNative420, "Supports compressed video transport for 4:2:0 pixel encoding"},
+		{hf.QMSTFRMax, "Supports QMS TFRmax"},
+		{hf.QMSTFRMin, "Supports QMS TFRmin"},
+		{hf.DSCAllBPP, "Supports compressed video transport at any valid 1/16th bit bpp"},
+		{hf.DSC16BPC, "Supports 16 bpc compressed video transport"},
+		{hf.DSC12BPC, "Supports 12 bpc compressed video transport"},
+		{hf.DSC10BPC, "Supports 10 bpc compressed video transport"},
+	}
+	for _, flag := range dscFlags {
+		if flag.set {
+			fmt.Printf("\t\t%s\n", flag.name)
+		}
+	}
+	if hf.DSCFRLLanes != 0 {
+		fmt.Printf("\t\tDSC maximum FRL rate: %d Gbps per lane on %d lanes\n", hf.DSCFRLRate, hf.DSCFRLLanes)
+	}
+	if hf.DSCMaxSlices != 0 {
+		fmt.Printf("\t\tDSC maximum slices: %d at up to %d MHz per slice\n", hf.DSCMaxSlices, hf.DSCMaxPixelClock)
+	}
+	if hf.DSCTotalChunkBytes != 0 {
+		fmt.Printf("\t\tDSC maximum bytes in a line of chunks: %d\n", hf.DSCTotalChunkBytes)
+	}
+}
+
+func ouiString(oui uint32) string {
+	return fmt.Sprintf("%02X-%02X-%02X", byte(oui>>16), byte(oui>>8), byte(oui))
+}
+
+func printCTADataBlock(block CTADataBlock) {
+	fmt.Printf("\t%s, %d payload bytes\n", ctaDataBlockName(block), block.Length)
+	switch block.Tag {
+	case CTA_EXT_TAG_AUDIO_DATA_BLOCK:
+		printAudioDataBlock(block.AudioDescriptors)
+	case CTA_EXT_TAG_VIDEO_DATA_BLOCK:
+		printVideoDataBlock(block.VideoDescriptors)
+	case CTA_EXT_TAG_SPEAKER_ALLOCATION_DATA_BLOCK:
+		printSpeakers(block.Speakers)
+	case CTA_EXT_TAG_VENDOR_SPECIFIC_DATA_BLOCK:
+		fmt.Printf("\t\tOUI: %s\n", ouiString(block.OUI))
+		if block.HDMI != nil {
+			printHDMIVSDB(block.HDMI)
+		}
+		if block.HDMIForum != nil {
+			printHDMIForumSCDS(block.HDMIForum)
+		}
+	case CTA_EXT_TAG_USE_EXTENDED_TAG:
+		printExtendedDataBlock(block)
+	}
+}
+
+func printColorimetry(colorimetry *Colorimetry) {
+	for _, name := range colorimetry.Colorimetries {
+		fmt.Printf("\t\t%s\n", name)
+	}
+	for _, profile := range colorimetry.MetadataProfiles {
+		fmt.Printf("\t\tGamut metadata profile MD%d\n", profile)
+	}
+}
+
+func printVideoCapability(capability *VideoCapability) {
+	fmt.Printf("\t\tYCbCr quantization selectable: %t\n", capability.QuantizationYCC)
+	fmt.Printf("\t\tRGB quantization selectable: %t\n", capability.QuantizationRGB)
+	fmt.Printf("\t\tPreferred video timing scan behavior: %s\n", capability.PreferredScan)
+	fmt.Printf("\t\tIT video format scan behavior: %s\n", capability.ITScan)
+	fmt.Printf("\t\tCE video format scan behavior: %s\n", capability.CEScan)
+}
+
+func printHDRStaticMetadata(hdr *HDRStaticMetadata) {
+	fmt.Println("\t\tElectro-optical transfer functions:")
+	for _, eotf := range hdr.EOTFs {
+		fmt.Printf("\t\t\t%s\n", eotf)
+	}
+	fmt.Println("\t\tSupported static metadata descriptors:")
+	for _, metadataType := range hdr.MetadataTypes {
+		fmt.Printf("\t\t\tStatic metadata type %d\n", metadataType)
+	}
+	if hdr.MaxLuminance != 0 {
+		fmt.Printf("\t\tDesired content max luminance: %.3f cd/m^2\n", hdr.MaxLuminance)
+	}
+	if hdr.MaxFrameAverageLuminance != 0 {
+		fmt.Printf("\t\tDesired content max frame-average luminance: %.3f cd/m^2\n", hdr.MaxFrameAverageLuminance)
+	}
+	if hdr.MinLuminancePresent {
+		fmt.Printf("\t\tDesired content min luminance: %.3f cd/m^2\n", hdr.MinLuminance)
+	}
+}
+
+func printHDRDynamicMetadata(metadata []HDRDynamicMetadata) {
+	for _, entry := range metadata {
+		fmt.Printf("\t\tHDR dynamic metadata type %d: %s\n", entry.Type, entry.Name)
+		if entry.Version >= 0 {
+			fmt.Printf("\t\t\tVersion: %d\n", entry.Version)
+		}
+		for _, flag := range entry.Flags {
+			fmt.Printf("\t\t\t%s\n", flag)
+		}
+	}
+}
+
+func printExtendedDataBlock(block CTADataBlock) {
+	switch block.ExtendedTag {
+	case CTA_EXTENDED_TAG_VIDEO_CAPABILITY_DATA_BLOCK:
+		if block.VideoCapability != nil {
+			printVideoCapability(block.VideoCapability)
+		}
+	case CTA_EXTENDED_TAG_COLORIMETRY_DATA_BLOCK:
+		if block.Colorimetry != nil {
+			printColorimetry(block.Colorimetry)
+		}
+	case CTA_EXTENDED_TAG_YCBCR420_VIDEO_DATA_BLOCK:
+		printVideoDataBlock(block.VideoDescriptors)
+	case CTA_EXTENDED_TAG_YCBCR420_CAPABILITY_MAP_DATA_BLOCK:
+		if block.YCbCr420MapAll {
+			fmt.Println("\t\tAll SVDs support YCbCr 4:2:0")
+		}
+		for _, index := range block.YCbCr420Map {
+			fmt.Printf("\t\tSVD %d supports YCbCr 4:2:0\n", index)
+		}
+	case CTA_EXTENDED_TAG_ROOM_CONFIGURATION_DATA_BLOCK:
+		if block.RoomConfig != nil {
+			printRoomConfiguration(block.RoomConfig)
+		}
+	case CTA_EXTENDED_TAG_SPEAKER_LOCATION_DATA_BLOCK:
+		printSpeakerLocations(block.SpeakerLocations)
+	case CTA_EXTENDED_TAG_HDR_STATIC_METADATA_DATA_BLOCK:
+		if block.HDRStatic != nil {
+			printHDRStaticMetadata(block.HDRStatic)
+		}
+	case CTA_EXTENDED_TAG_HDR_DYNAMIC_METADATA_DATA_BLOCK:
+		printHDRDynamicMetadata(block.HDRDynamic)
+	case CTA_EXTENDED_TAG_HF_SCDB_DATA_BLOCK:
+		if block.HDMIForum != nil {
+			printHDMIForumSCDS(block.HDMIForum)
+		}
+	}
+}
+
+func printCTAExtension(cta *CTAExtension) {
+	fmt.Println("Parsing CTA extension")
+	fmt.Printf("\tRevision: %d\n", cta.Revision)
+	fmt.Printf("\tDTD offset: 0x%02x\n", cta.DTDOffset)
+	if cta.Revision >= 2 {
+		fmt.Printf("\tUnderscans IT video formats: %t\n", cta.Underscan)
+		fmt.Printf("\tBasic audio: %t\n", cta.BasicAudio)
+		fmt.Printf("\tYCbCr 4:4:4: %t\n", cta.YCbCr444)
+		fmt.Printf("\tYCbCr 4:2:2: %t\n", cta.YCbCr422)
+		fmt.Printf("\tNative DTDs: %d\n", cta.NativeDTDs)
+	}
+	fmt.Println("Data block collection:")
+	for _, block := range cta.DataBlocks {
+		printCTADataBlock(block)
+	}
+	fmt.Println("Detailed timing descriptors:")
+	for i, dtd := range cta.DetailedTimings {
+		fmt.Printf("\tDetailed Timing Descriptor %d\n", i)
+		printDisplayTimingDescriptor(dtd)
+	}
+}
@@ -0,0 +1,391 @@
+package edid
+
+import (
+	"fmt"
+)
+
+func printTiledDisplayTopology(block DisplayIDBlock) {
+	topology := block.TiledTopology
+	fmt.Println("Parsing tiled display topology")
+	fmt.Printf("\tRevision: 0x%02x\n", block.Revision)
+	fmt.Printf("\tNumber of payload bytes: %d\n", block.Length)
+
+	switch topology.OneTileBehavior {
+	case 0x00:
+		fmt.Println("\tOne tile behavior: Undefined")
+	case 0x01:
+		fmt.Println("\tOne tile behavior: Image is displayed at the tile location")
+	case 0x02:
+		fmt.Println("\tOne tile behavior: Image is scaled to fit the entire tiled display")
+	case 0x03:
+		fmt.Println("\tOne tile behavior: Image is cloned to all other tiles")
+	default:
+		fmt.Printf("\tOne tile behavior: Reserved (%d)\n", topology.OneTileBehavior)
+	}
+
+	switch topology.NTileBehavior {
+	case 0x00:
+		fmt.Println("\tN tile behavior: Undefined")
+	case 0x01:
+		fmt.Println("\tN tile behavior: Image is displayed at the tile location")
+	default:
+		fmt.Printf("\tN tile behavior: Reserved (%d)\n", topology.NTileBehavior)
+	}
+
+	if !topology.SingleEnclosure {
+		fmt.Println("\tPhysical enclosure: Multiple physical enclosures")
+	} else {
+		fmt.Println("\tPhysical enclosure: Single physical enclosure")
+	}
+
+	fmt.Printf("\tNumber of tiles: %d x %d\n", topology.HorizontalTiles, topology.VerticalTiles)
+	fmt.Printf("\tTile location: %d, %d\n", topology.HorizontalLocation, topology.VerticalLocation)
+	fmt.Printf("\tTile size: %d x %d\n", topology.TileWidth, topology.TileHeight)
+
+	if topology.BezelInformation {
+		fmt.Printf("\tPixel multiplier: %d\n", topology.PixelMultiplier)
+		fmt.Printf("\tTop bezel size: %.1f pixels\n", topology.TopBezel)
+		fmt.Printf("\tBottom bezel size: %.1f pixels\n", topology.BottomBezel)
+		fmt.Printf("\tRight bezel size: %.1f pixels\n", topology.RightBezel)
+		fmt.Printf("\tLeft bezel size: %.1f pixels\n", topology.LeftBezel)
+	} else {
+		fmt.Println("\tBezel description: None")
+	}
+
+	fmt.Printf("\tTiled display vendor ID: %s\n", topology.VendorID)
+	fmt.Printf("\tTiled display product ID: %d\n", topology.ProductCode)
+	fmt.Printf("\tTiled display serial number: %d\n", topology.SerialNumber)
+}
+
+func syncPolarityString(positive bool) string {
+	if positive {
+		return "P"
+	}
+	return "N"
+}
+
+func printVTBTimings(block DisplayIDBlock, name string) {
+	fmt.Printf("Parsing %s\n", name)
+	fmt.Printf("\tRevision: 0x%02x\n", block.Revision)
+	fmt.Printf("\tNumber of payload bytes: %d\n", block.Length)
+	fmt.Printf("\tNumber of video timing blocks: %d\n", len(block.Timings))
+
+	for i, t := range block.Timings {
+		fmt.Printf("Video timing block %d\n", i+1)
+		fmt.Printf("\tPixel clock: %fMHz\n", t.PixelClock)
+		switch {
+		case t.Code != "":
+			fmt.Printf("\tTiming code: %s\n", t.Code)
+		case t.Formula != "":
+			fmt.Printf("\tTiming options: 0x%02x\n", t.Options)
+			fmt.Printf("\tFormula: %s, stereo: %s\n", t.Formula, t.Stereo)
+		default:
+			fmt.Printf("\tTiming options: 0x%02x\n", t.Options)
+			fmt.Printf("\tPreferred: %t, interlaced: %t, stereo: %s, aspect ratio: %s\n", t.Preferred, t.Interlaced, t.Stereo, t.AspectRatio)
+		}
+		for _, flag := range t.Flags {
+			fmt.Printf("\t%s\n", flag)
+		}
+		hBackPorch := t.HorizontalBlanking - t.HorizontalFrontPorch - t.HorizontalSyncWidth
+		fmt.Printf("\tha: %d, hbl: %d, hfp: %d, hbp; %d, hsync: %d, Hpol %s\n", t.HorizontalActive, t.HorizontalBlanking, t.HorizontalFrontPorch, hBackPorch, t.HorizontalSyncWidth, syncPolarityString(t.HorizontalSyncPositive))
+		vBackPorch := t.VerticalBlanking - t.VerticalFrontPorch - t.VerticalSyncWidth
+		fmt.Printf("\tva: %d, vbl: %d, vfp: %d, vbp; %d, vsync: %d, Vpol %s\n", t.VerticalActive, t.VerticalBlanking, t.VerticalFrontPorch, vBackPorch, t.VerticalSyncWidth, syncPolarityString(t.VerticalSyncPositive))
+		fmt.Printf("\tTotal: %d x %d, refresh rate: %fHz\n", t.HorizontalActive+t.HorizontalBlanking, t.VerticalActive+t.VerticalBlanking, t.RefreshRate)
+	}
+}
+
+func printProductIdentification(product *DisplayIDProductIdentification) {
+	fmt.Println("Parsing product identification")
+	fmt.Printf("\tVendor ID: %s\n", product.VendorID)
+	fmt.Printf("\tProduct code: %d\n", product.ProductCode)
+	fmt.Printf("\tSerial number: %d\n", product.SerialNumber)
+	if product.ModelYear {
+		fmt.Printf("\tModel year: %d\n", product.YearOfManufacture)
+	} else {
+		if product.WeekOfManufacture != 0 {
+			fmt.Printf("\tWeek of manufacture: %d\n", product.WeekOfManufacture)
+		}
+		fmt.Printf("\tYear of manufacture: %d\n", product.YearOfManufacture)
+	}
+	if product.ProductName != "" {
+		fmt.Printf("\tProduct name: %s\n", product.ProductName)
+	}
+}
+
+func printDisplayParameters(params *DisplayIDDisplayParameters) {
+	fmt.Println("Parsing display parameters")
+	fmt.Printf("\tImage size: %.1f mm x %.1f mm\n", params.ImageWidth, params.ImageHeight)
+	if params.HorizontalPixels != 0 && params.VerticalPixels != 0 {
+		fmt.Printf("\tNative resolution: %d x %d\n", params.HorizontalPixels, params.VerticalPixels)
+	}
+	for _, feature := range params.Features {
+		fmt.Printf("\tSupports %s\n", feature)
+	}
+	if params.AspectRatio != 0 {
+		fmt.Printf("\tAspect ratio: %.2f\n", params.AspectRatio)
+	}
+	if params.NativeBitDepth != 0 {
+		fmt.Printf("\tBits per color: %d native, %d overall\n", params.NativeBitDepth, params.OverallBitDepth)
+	}
+	if params.ScanOrientation != "" {
+		fmt.Printf("\tScan orientation: %s\n", params.ScanOrientation)
+		fmt.Printf("\tLuminance information: %s\n", params.LuminanceInformation)
+		fmt.Printf("\tAudio speakers integrated: %t\n", params.AudioIntegrated)
+		fmt.Printf("\tColor primaries (%s):\n", params.ColorSpace)
+		p := params.Primaries
+		fmt.Printf("\t\tRed: %.4f, %.4f\n", p.RedX, p.RedY)
+		fmt.Printf("\t\tGreen: %.4f, %.4f\n", p.GreenX, p.GreenY)
+		fmt.Printf("\t\tBlue: %.4f, %.4f\n", p.BlueX, p.BlueY)
+		fmt.Printf("\t\tWhite: %.4f, %.4f\n", p.WhiteX, p.WhiteY)
+		fmt.Printf("\tMax luminance (full coverage): %.2f cd/m^2\n", params.MaxLuminanceFull)
+		fmt.Printf("\tMax luminance (10%% coverage): %.2f cd/m^2\n", params.MaxLuminance10)
+		fmt.Printf("\tMin luminance: %.4f cd/m^2\n", params.MinLuminance)
+		if params.BitDepth != 0 {
+			fmt.Printf("\tColor bit depth: %d bits per primary\n", params.BitDepth)
+		}
+		fmt.Printf("\tDevice technology: %s\n", params.Technology)
+	}
+	if params.Gamma != 0 {
+		fmt.Printf("\tGamma: %.2f\n", params.Gamma)
+	}
+}
+
+func printInterfaceFeatures(features *DisplayIDInterfaceFeatures) {
+	fmt.Println("Parsing display interface features")
+	fmt.Printf("\tRGB bit depths: %v\n", features.RGBDepths)
+	fmt.Printf("\tYCbCr 4:4:4 bit depths: %v\n", features.YCbCr444Depths)
+	fmt.Printf("\tYCbCr 4:2:2 bit depths: %v\n", features.YCbCr422Depths)
+	fmt.Printf("\tYCbCr 4:2:0 bit depths: %v\n", features.YCbCr420Depths)
+	if features.MinPixelRate420 != 0 {
+		fmt.Printf("\tMinimum pixel rate for YCbCr 4:2:0: %.2f MHz\n", features.MinPixelRate420)
+	}
+	for _, rate := range features.AudioSampleRates {
+		fmt.Printf("\tAudio sample rate: %s\n", rate)
+	}
+	for _, colorSpace := range features.ColorSpaces {
+		fmt.Printf("\tColor space and EOTF: %s\n", colorSpace)
+	}
+}
+
+func printStereoInterface(stereo *DisplayIDStereoInterface) {
+	fmt.Println("Parsing stereo display interface")
+	fmt.Printf("\tTiming support: %s\n", stereo.TimingSupport)
+	fmt.Printf("\tMethod: %s\n", stereo.Method)
+	for _, detail := range stereo.Details {
+		fmt.Printf("\t\t%s\n", detail)
+	}
+	for _, t := range stereo.Timings {
+		fmt.Printf("\tStereo timing %s: %dx%d@%.2fHz\n", t.Code, t.HorizontalActive, t.VerticalActive, t.RefreshRate)
+	}
+}
+
+func printAdaptiveSync(descriptors []DisplayIDAdaptiveSync) {
+	fmt.Println("Parsing adaptive sync")
+	for i, sync := range descriptors {
+		fmt.Printf("\tDescriptor %d:\n", i+1)
+		fmt.Printf("\t\tNative panel range: %t\n", sync.NativePanelRange)
+		fmt.Printf("\t\t%s\n", sync.VTotal)
+		if sync.SeamlessTransition {
+			fmt.Println("\t\tSupports seamless transition")
+		}
+		fmt.Printf("\t\tRefresh rate: %d - %d Hz\n", sync.MinRefreshRate, sync.MaxRefreshRate)
+		fmt.Printf("\t\tMax duration increase: %.2f ms, no jitter impact: %t\n", sync.MaxDurationIncrease, sync.MaxIncreaseNoJitter)
+		fmt.Printf("\t\tMax duration decrease: %.2f ms, no jitter impact: %t\n", sync.MaxDurationDecrease, sync.MaxDecreaseNoJitter)
+	}
+}
+
+func printColorCharacteristics(color *DisplayIDColorCharacteristics) {
+	fmt.Println("Parsing color characteristics")
+	if color.Temporal {
+		fmt.Println("\tUses temporal color")
+	} else {
+		fmt.Println("\tUses spatial color")
+	}
+	if color.CIE1976 {
+		fmt.Println("\tUses CIE 1976 coordinates")
+	} else {
+		fmt.Println("\tUses CIE 1931 coordinates")
+	}
+	if color.TransferID != 0 {
+		fmt.Printf("\tTransfer characteristics identifier: %d\n", color.TransferID)
+	}
+	if color.ColorSpace != "" {
+		fmt.Printf("\tColor space: %s\n", color.ColorSpace)
+	}
+	for i, p := range color.Primaries {
+		fmt.Printf("\tPrimary %d: %.4f, %.4f\n", i, p.X, p.Y)
+	}
+	for i, p := range color.WhitePoints {
+		fmt.Printf("\tWhite point %d: %.4f, %.4f\n", i, p.X, p.Y)
+	}
+}
+
+func printRangeLimits(limits *DisplayIDRangeLimits) {
+	fmt.Println("Parsing video timing range limits")
+	fmt.Printf("\tPixel clock: %.2f - %.2f MHz\n", limits.MinPixelClock, limits.MaxPixelClock)
+	fmt.Printf("\tHorizontal frequency: %d - %d kHz\n", limits.MinHorizontalRate, limits.MaxHorizontalRate)
+	fmt.Printf("\tMinimum horizontal blanking: %d pixels\n", limits.MinHBlank)
+	fmt.Printf("\tVertical refresh: %d - %d Hz\n", limits.MinVerticalRate, limits.MaxVerticalRate)
+	fmt.Printf("\tMinimum vertical blanking: %d lines\n", limits.MinVBlank)
+	fmt.Printf("\tInterlaced: %t, CVT: %t, CVT reduced blanking: %t\n", limits.Interlaced, limits.CVT, limits.CVTReduced)
+	if limits.DiscreteFrequency {
+		fmt.Println("\tDiscrete frequency display device")
+	}
+}
+
+func printPowerSequencing(power *DisplayIDPowerSequencing) {
+	fmt.Println("Parsing power sequencing")
+	fmt.Printf("\tT1: %.1f - %.1f ms\n", power.T1Min, power.T1Max)
+	fmt.Printf("\tT2: 0.0 - %.1f ms\n", power.T2Max)
+	fmt.Printf("\tT3: 0.0 - %.1f ms\n", power.T3Max)
+	fmt.Printf("\tT4 minimum: %.1f ms\n", power.T4Min)
+	fmt.Printf("\tT5 minimum: %.1f ms\n", power.T5Min)
+	fmt.Printf("\tT6 minimum: %.1f ms\n", power.T6Min)
+}
+
+func printTransferCharacteristics(transfer *DisplayIDTransferCharacteristics) {
+	fmt.Println("Parsing transfer characteristics")
+	if transfer.ID != 0 {
+		fmt.Printf("\tIdentifier: %d\n", transfer.ID)
+	}
+	if transfer.IndividualCurves {
+		fmt.Println("\tIndividual response curves")
+	}
+	for _, curve := range transfer.Curves {
+		if curve.Parameters != nil {
+			fmt.Printf("\t%s: A0=%d A1=%d A2=%d A3=%d gamma=%.2f\n", curve.Name, curve.Parameters[0], curve.Parameters[1], curve.Parameters[2], curve.Parameters[3], curve.Gamma)
+			continue
+		}
+		fmt.Printf("\t%s:", curve.Name)
+		for _, sample := range curve.Samples {
+			fmt.Printf(" %.2f", sample)
+		}
+		fmt.Println()
+	}
+}
+
+func printLegacyInterface(iface *DisplayIDLegacyInterface) {
+	fmt.Println("Parsing display interface")
+	fmt.Printf("\tInterface type: %s\n", iface.Type)
+	if iface.Channels != 0 {
+		fmt.Printf("\tChannels: %d\n", iface.Channels)
+	}
+	fmt.Printf("\tInterface standard version: %s\n", iface.Version)
+	fmt.Printf("\tRGB bit depths: %v\n", iface.RGBDepths)
+	fmt.Printf("\tYCbCr 4:4:4 bit depths: %v\n", iface.YCbCr444Depths)
+	fmt.Printf("\tYCbCr 4:2:2 bit depths: %v\n", iface.YCbCr422Depths)
+	fmt.Printf("\tContent protection: %s, version %s\n", iface.ContentProtection, iface.ContentProtectionVersion)
+	fmt.Printf("\tSpread spectrum: %s, %.1f%%\n", iface.SpreadSpectrum, iface.SpreadSpectrumPercent)
+}
+
+func printDisplayIDSection(section DisplayIDSection) {
+	fmt.Printf("DisplayID revision: 0x%02x\n", section.Revision)
+	fmt.Printf("DisplayID variable length: 0x%02x\n", section.Length)
+	fmt.Printf("Product type: %s\n", section.ProductTypeName)
+	fmt.Printf("Extension count: 0x%02x\n", section.ExtensionCount)
+
+	for _, block := range section.Blocks {
+		fmt.Printf("Block type tag: 0x%02x\n", block.Tag)
+		switch block.Tag {
+		case CTA_BLOCK_PRODUCT_ID, CTA_BLOCK_PRODUCT_ID_LEGACY:
+			if block.ProductID != nil {
+				printProductIdentification(block.ProductID)
+			}
+		case CTA_BLOCK_DISPLAY_PARAMS, CTA_BLOCK_DISPLAY_PARAMS_LEGACY:
+			if block.Parameters != nil {
+				printDisplayParameters(block.Parameters)
+			}
+		case CTA_BLOCK_INTERFACE_FEATURES:
+			if block.Interface != nil {
+				printInterfaceFeatures(block.Interface)
+			}
+		case CTA_BLOCK_STEREO_INTERFACE:
+			if block.Stereo != nil {
+				printStereoInterface(block.Stereo)
+			}
+		case CTA_BLOCK_CONTAINER_ID:
+			fmt.Printf("ContainerID: %s\n", block.ContainerID)
+		case CTA_BLOCK_ADAPTIVE_SYNC:
+			printAdaptiveSync(block.AdaptiveSync)
+		case CTA_BLOCK_VENDOR_SPECIFIC, CTA_BLOCK_VENDOR_SPECIFIC_LEGACY:
+			fmt.Println("Parsing vendor-specific block")
+			fmt.Printf("\tOUI: %s\n", ouiString(block.OUI))
+			fmt.Printf("\tPayload: % x\n", block.VendorData)
+		case CTA_BLOCK_TILED_DISPLAY, CTA_BLOCK_TILED_DISPLAY_LEGACY:
+			if block.TiledTopology != nil {
+				printTiledDisplayTopology(block)
+			}
+		case CTA_BLOCK_VTB_TYPE_1:
+			fmt.Println("VTB type 1")
+			printVTBTimings(block, "VTB type 1")
+		case CTA_BLOCK_VTB_TYPE_2:
+			fmt.Println("VTB type 2")
+			printVTBTimings(block, "VTB type 2")
+		case CTA_BLOCK_VTB_TYPE_3:
+			fmt.Println("VTB type 3")
+			printVTBTimings(block, "VTB type 3")
+		case CTA_BLOCK_VTB_TYPE_4:
+			fmt.Println("VTB type 4")
+			printVTBTimings(block, "VTB type 4")
+		case CTA_BLOCK_VESA_TIMINGS:
+			printVTBTimings(block, "VESA timings")
+		case CTA_BLOCK_CTA_TIMINGS:
+			printVTBTimings(block, "CTA-861 timings")
+		case CTA_BLOCK_COLOR_CHARACTERISTICS:
+			if block.ColorCharacteristics != nil {
+				printColorCharacteristics(block.ColorCharacteristics)
+			}
+		case CTA_BLOCK_RANGE_LIMITS:
+			if block.RangeLimits != nil {
+				printRangeLimits(block.RangeLimits)
+			}
+		case CTA_BLOCK_SERIAL_NUMBER:
+			fmt.Printf("Serial number: %s\n", block.String)
+		case CTA_BLOCK_ASCII_STRING:
+			fmt.Printf("ASCII string: %s\n", block.String)
+		case CTA_BLOCK_POWER_SEQUENCING:
+			if block.PowerSequencing != nil {
+				printPowerSequencing(block.PowerSequencing)
+			}
+		case CTA_BLOCK_TRANSFER_CHARACTERISTICS:
+			if block.Transfer != nil {
+				printTransferCharacteristics(block.Transfer)
+			}
+		case CTA_BLOCK_DISPLAY_INTERFACE:
+			if block.LegacyInterface != nil {
+				printLegacyInterface(block.LegacyInterface)
+			}
+		case CTA_BLOCK_VTB_TYPE_7:
+			fmt.Println("VTB type 7")
+			printVTBTimings(block, "VTB type 7")
+		case CTA_BLOCK_VTB_TYPE_8:
+			fmt.Println("VTB type 8")
+			printVTBTimings(block, "VTB type 8")
+		case CTA_BLOCK_VTB_TYPE_9:
+			fmt.Println("VTB type 9")
+			printVTBTimings(block, "VTB type 9")
+		case CTA_BLOCK_VTB_TYPE_10:
+			fmt.Println("VTB type 10")
+			printVTBTimings(block, "VTB type 10")
+		default:
+			fmt.Println("Unknown block type")
+		}
+	}
+	fmt.Printf("DisplayID checksum: 0x%02x is: %t\n", section.Checksum, section.ChecksumValid)
+}
+
+func printDisplayIDExtension(displayID *DisplayID) {
+	fmt.Println("Parsing DisplayID extension")
+	for _, section := range displayID.Sections {
+		printDisplayIDSection(section)
+	}
+	fmt.Println("End of DisplayID extension")
+}
+
+func (decoded DecodedDisplayID) Print() {
+	fmt.Println("Parsing DisplayID structure")
+	for _, section := range decoded.Sections {
+		printDisplayIDSection(section)
+	}
+	fmt.Println("End of DisplayID structure")
+}
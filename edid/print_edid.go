@@ -0,0 +1,374 @@
+package edid
+
+import (
+	"fmt"
+	"strings"
+)
+
+func timingString(t Timing) string {
+	if t.Interlaced {
+		return fmt.Sprintf("%dx%di @ %.2fHz", t.HorizontalActive, 2*t.VerticalActive, t.RefreshRate)
+	}
+	return fmt.Sprintf("%dx%dp @ %.2fHz", t.HorizontalActive, t.VerticalActive, t.RefreshRate)
+}
+
+func printBDP(bdp BasicDisplayParameters) {
+	if bdp.Digital {
+		fmt.Println("\tDigital Input")
+		if bdp.BitDepth == 0 {
+			fmt.Println("\tUndefined")
+		} else {
+			fmt.Printf("\t%d bits per color\n", bdp.BitDepth)
+		}
+		fmt.Println("\t" + bdp.VideoInterface)
+	} else {
+		fmt.Println("\tAnalog Input")
+		fmt.Println("\t" + bdp.WhiteAndSyncLevels)
+		if bdp.BlankToBlackSetup {
+			fmt.Println("\tBlank to black setup (pedestal) expected")
+		} else {
+			fmt.Println("\tBlank to black setup (pedestal) not expected")
+		}
+		if bdp.SeparateSync {
+			fmt.Println("\tSeparate sync levels supported")
+		} else {
+			fmt.Println("\tSeparate sync levels not supported")
+		}
+		if bdp.CompositeSync {
+			fmt.Println("\tComposite sync supported")
+		} else {
+			fmt.Println("\tComposite sync not supported")
+		}
+		if bdp.SyncOnGreen {
+			fmt.Println("\tSync on green supported")
+		} else {
+			fmt.Println("\tSync on green not supported")
+		}
+		if bdp.VSyncSerrated {
+			fmt.Println("\tVsync serrated")
+		} else {
+			fmt.Println("\tVsync not serrated")
+		}
+	}
+	fmt.Println("\tMaximum Image Size: ", bdp.MaxHorizontalSize, "cm x ", bdp.MaxVerticalSize, "cm")
+	fmt.Println("\tDisplay Gamma: ", bdp.Gamma)
+	if bdp.DPMSStandby {
+		fmt.Println("\tDPMS standby supported")
+	}
+	if bdp.DPMSSuspend {
+		fmt.Println("\tDPMS suspend supported")
+	}
+	if bdp.DPMSActiveOff {
+		fmt.Println("\tDPMS active-off supported")
+	}
+	fmt.Println("\tDisplay type: " + bdp.DisplayType)
+}
+
+func printChromaticityCoordinates(cc ChromaticityCoordinates) {
+	fmt.Println("\tRed X:", cc.RedX, " Red Y:", cc.RedY)
+	fmt.Println("\tGreen X:", cc.GreenX, " Green Y:", cc.GreenY)
+	fmt.Println("\tBlue X:", cc.BlueX, " Blue Y:", cc.BlueY)
+	fmt.Println("\tWhite X:", cc.WhiteX, " White Y:", cc.WhiteY)
+}
+
+func printEstablishedTimings(et []EstablishedTiming, indent string) {
+	for _, t := range et {
+		if t.ReducedBlanking {
+			fmt.Printf("%s%dx%d @ %dHz (reduced blanking)\n", indent, t.HorizontalActive, t.VerticalActive, t.RefreshRate)
+		} else {
+			fmt.Printf("%s%dx%d @ %dHz\n", indent, t.HorizontalActive, t.VerticalActive, t.RefreshRate)
+		}
+	}
+}
+
+func printStandardTimings(st []StandardTiming, indent string) {
+	for i, t := range st {
+		if t.Unused {
+			fmt.Printf("%sStandard Timing %d: Unused\n", indent, i)
+			continue
+		}
+		fmt.Printf("%sStandard Timing %d: %d x %d @ %dHz (%s)\n", indent, i, t.HorizontalActive, t.VerticalActive, t.RefreshRate, t.AspectRatio)
+	}
+}
+
+func printDisplayDescriptorFeatures(t Timing, fd DetailedTimingFeatures) {
+	if t.Interlaced {
+		fmt.Println("\t\t\tSignal type: Interlaced")
+	} else {
+		fmt.Println("\t\t\tSignal type: Progressive")
+	}
+	fmt.Println("\t\t\t" + fd.Stereo)
+	if fd.DigitalSync {
+		fmt.Println("\t\t\tDigital sync")
+		if !fd.SeparateSync {
+			fmt.Println("\t\t\tDigital composite sync")
+			if fd.Serrated {
+				fmt.Println("\t\t\tDigital serrated vsync")
+			} else {
+				fmt.Println("\t\t\tDigital vsync not serrated")
+			}
+		} else {
+			fmt.Println("\t\t\tDigital separate sync")
+			if t.VerticalSyncPositive {
+				fmt.Println("\t\t\tDigital VSync positive")
+			} else {
+				fmt.Println("\t\t\tDigital VSync negative")
+			}
+		}
+		if t.HorizontalSyncPositive {
+			fmt.Println("\t\t\tDigital HSync positive")
+		} else {
+			fmt.Println("\t\t\tDigital HSync negative")
+		}
+	} else {
+		fmt.Println("\t\t\tAnalog sync")
+		if fd.BipolarSync {
+			fmt.Println("\t\t\tAnalog bipolar composite sync ")
+		} else {
+			fmt.Println("\t\t\tAnalog composite sync")
+		}
+		if fd.Serrated {
+			fmt.Println("\t\t\tAnalog serrated vsync")
+		} else {
+			fmt.Println("\t\t\tAnalog vsync not serrated")
+		}
+		if fd.SyncOnAllRGB {
+			fmt.Println("\t\t\tAnalog sync on all RGB signals")
+		} else {
+			fmt.Println("\t\t\tAnalog sync on green")
+		}
+	}
+}
+
+func printDisplayTimingDescriptor(dtd DetailedTimingDescriptor) {
+	fmt.Printf("\t\tPixel Clock: %f MHz\n", dtd.PixelClock)
+	fmt.Printf("\t\tHorizontal Active: %d\n", dtd.HorizontalActive)
+	fmt.Printf("\t\tVertical Active: %d\n", dtd.VerticalActive)
+	fmt.Printf("\t\tHorizontal Blanking: %d", dtd.HorizontalBlanking)
+	fmt.Printf(" Front Porch: %d", dtd.HorizontalFrontPorch)
+	fmt.Printf(" Sync Pulse: %d\n", dtd.HorizontalSyncWidth)
+	fmt.Printf("\t\tVertical Blanking: %d", dtd.VerticalBlanking)
+	fmt.Printf(" Front Porch: %d", dtd.VerticalFrontPorch)
+	fmt.Printf(" Sync Pulse: %d\n", dtd.VerticalSyncWidth)
+	fmt.Printf("\t\tImage Size: %dmm x %dmm\n", dtd.ImageWidth, dtd.ImageHeight)
+	fmt.Printf("\t\tHorizontal Border: %d\n", dtd.HorizontalBorder)
+	fmt.Printf("\t\tVertical Border: %d\n", dtd.VerticalBorder)
+	fmt.Printf("\t\tFeatures:\n")
+	printDisplayDescriptorFeatures(dtd.Timing, dtd.Features)
+}
+
+// rangeLimitsTimingName names the timing formula of a range limits
+// descriptor. EDID 1.4 flags CVT with 0x04, 0x03 is reserved.
+func rangeLimitsTimingName(timingType byte) string {
+	switch timingType {
+	case RANGE_LIMITS_DEFAULT_GTF:
+		return "Default GTF"
+	case RANGE_LIMITS_ONLY:
+		return "No timing information"
+	case RANGE_LIMITS_SECONDARY_GTF:
+		return "Secondary GTF supported"
+	case RANGE_LIMITS_CVT:
+		return "CVT supported"
+	default:
+		return "Reserved"
+	}
+}
+
+func printDisplayRangeLimitDescriptor(limits RangeLimits) {
+	fmt.Printf("\t\tVertical Field Rate: %d - %d Hz\n", limits.VerticalRateMin, limits.VerticalRateMax)
+	fmt.Printf("\t\tHorizontal Line Rate: %d - %d kHz\n", limits.HorizontalRateMin, limits.HorizontalRateMax)
+	fmt.Printf("\t\tMax Pixel Clock: %d MHz\n", limits.MaxPixelClock)
+	fmt.Println("\t\t" + rangeLimitsTimingName(limits.ExtendedTimingType))
+	switch limits.ExtendedTimingType {
+	case RANGE_LIMITS_SECONDARY_GTF:
+		gtf := limits.SecondaryGTF
+		fmt.Printf("\t\t\tStart Frequency: %d kHz\n", gtf.StartFrequency)
+		fmt.Printf("\t\t\tC: %.1f%%, M: %d%%/kHz, K: %d, J: %.1f%%\n", gtf.C, gtf.M, gtf.K, gtf.J)
+	case RANGE_LIMITS_CVT:
+		cvt := limits.CVT
+		fmt.Printf("\t\t\tVersion: %s\n", cvt.Version)
+		fmt.Printf("\t\t\tMax Pixel Clock: %.2f MHz\n", cvt.MaxPixelClock)
+		if cvt.MaxActivePixels != 0 {
+			fmt.Printf("\t\t\tMax Active Pixels per Line: %d\n", cvt.MaxActivePixels)
+		}
+		fmt.Printf("\t\t\tSupported Aspect Ratios: %s\n", strings.Join(cvt.AspectRatios, ", "))
+		fmt.Printf("\t\t\tPreferred Aspect Ratio: %s\n", cvt.PreferredAspectRatio)
+		fmt.Printf("\t\t\tStandard Blanking: %t, Reduced Blanking: %t\n", cvt.StandardBlanking, cvt.ReducedBlanking)
+		if len(cvt.Scaling) != 0 {
+			fmt.Printf("\t\t\tScaling: %s\n", strings.Join(cvt.Scaling, ", "))
+		}
+		fmt.Printf("\t\t\tPreferred Refresh Rate: %d Hz\n", cvt.PreferredRefreshRate)
+	case RANGE_LIMITS_DEFAULT_GTF, RANGE_LIMITS_ONLY:
+	default:
+		fmt.Println("\t\tVideo Timing Parameters: ", limits.VideoTimingParameters[:])
+	}
+}
+
+func printCVTCodes(codes []CVTCode) {
+	for _, cvt := range codes {
+		fmt.Printf("\t\t%dx%d (%s), preferred refresh rate: %d Hz\n", cvt.HorizontalActive, cvt.VerticalActive, cvt.AspectRatio, cvt.PreferredRefreshRate)
+		for _, t := range cvt.Timings {
+			blanking := "standard blanking"
+			if t.ReducedBlanking {
+				blanking = "reduced blanking"
+			}
+			preferred := ""
+			if t.Preferred {
+				preferred = " (preferred)"
+			}
+			fmt.Printf("\t\t\t%d Hz %s%s: %s\n", t.NominalRefreshRate, blanking, preferred, timingString(t.Timing))
+		}
+	}
+}
+
+func printWhitePoints(points []WhitePoint) {
+	for _, wp := range points {
+		fmt.Println("\t\tWhite point index:", wp.Index)
+		fmt.Println("\t\t\tWhite X:", wp.X, " White Y:", wp.Y)
+		if wp.Gamma == 0 {
+			fmt.Println("\t\t\tGamma: defined in extension block")
+		} else {
+			fmt.Println("\t\t\tGamma:", wp.Gamma)
+		}
+	}
+}
+
+func printColorManagement(dcm ColorManagement) {
+	fmt.Println("\t\tVersion:", dcm.Version)
+	if dcm.Version != DCM_VERSION {
+		return
+	}
+	fmt.Printf("\t\tRed a3: %.2f a2: %.2f\n", dcm.RedA3, dcm.RedA2)
+	fmt.Printf("\t\tGreen a3: %.2f a2: %.2f\n", dcm.GreenA3, dcm.GreenA2)
+	fmt.Printf("\t\tBlue a3: %.2f a2: %.2f\n", dcm.BlueA3, dcm.BlueA2)
+}
+
+func printDisplayDescriptor(dd []DisplayDescriptor) {
+	for _, d := range dd {
+		i := d.Index
+		if d.DetailedTiming != nil {
+			fmt.Printf("\tDisplay Descriptor %d\n", i)
+			printDisplayTimingDescriptor(*d.DetailedTiming)
+			continue
+		}
+		switch d.Type {
+		case DTD_TYPE_MANUFACTURER_SPECIFIC:
+			fmt.Println("\tDisplay Descriptor ", i, ": Manufacturer specific: ", d.Text)
+		case DTD_TYPE_MONITOR_SERIAL_NUMBER:
+			fmt.Println("\tDisplay Descriptor ", i, ": Monitor serial number: ", d.Text)
+		case DTD_TYPE_UNSPECIFIED:
+			fmt.Println("\tDisplay Descriptor ", i, ": Unspecified")
+		case DTD_TYPE_RANGE_LIMITS:
+			fmt.Println("\tDisplay Descriptor ", i, ": Range limits")
+			printDisplayRangeLimitDescriptor(*d.RangeLimits)
+		case DTD_TYPE_MONITOR_NAME:
+			fmt.Println("\tDisplay Descriptor ", i, ": Monitor name: ", d.Text)
+		case DTD_TYPE_WHITE_POINT_DATA:
+			fmt.Println("\tDisplay Descriptor ", i, ": White point data")
+			printWhitePoints(d.WhitePoints)
+		case DTD_TYPE_STANDARD_TIMING_IDENTIFICATION:
+			fmt.Println("\tDisplay Descriptor ", i, ": Standard timing identification")
+			printStandardTimings(d.StandardTimings, "\t\t")
+		case DTD_TYPE_COLOR_POINT_DATA:
+			fmt.Println("\tDisplay Descriptor ", i, ": Color point data")
+			printColorManagement(*d.ColorManagement)
+		case DTD_TYPE_CVT_3_BYTE_CODE:
+			fmt.Println("\tDisplay Descriptor ", i, ": CVT 3-byte code")
+			printCVTCodes(d.CVTCodes)
+		case DTD_TYPE_ADDITIONAL_STANDARD_TIMING:
+			fmt.Println("\tDisplay Descriptor ", i, ": Established timings III")
+			printEstablishedTimings(d.EstablishedTimings, "\t\t")
+		case DTD_TYPE_DUMMY:
+			fmt.Println("\tDisplay Descriptor ", i, ": Dummy")
+		default:
+			fmt.Println("\tDisplay Descriptor ", i, ": Reserved")
+		}
+	}
+}
+
+func printModes(modes []Mode) {
+	for _, mode := range modes {
+		flags := ""
+		if mode.Native {
+			flags += " (native)"
+		}
+		if mode.Preferred {
+			flags += " (preferred)"
+		}
+		if mode.Timing != nil {
+			fmt.Printf("\t%s%s: %s\n", timingString(*mode.Timing), flags, mode.Source)
+			continue
+		}
+		fmt.Printf("\t%dx%d @ %gHz%s: %s\n", mode.HorizontalActive, mode.VerticalActive, mode.RefreshRate, flags, mode.Source)
+	}
+}
+
+func extensionTagName(tag byte) string {
+	switch tag {
+	case EXTENSION_TAG_CTA:
+		return "CTA-861 extension"
+	case EXTENSION_TAG_VTB:
+		return "Video timing block extension"
+	case EXTENSION_TAG_DI:
+		return "Display information extension"
+	case EXTENSION_TAG_LS:
+		return "Localized string extension"
+	case EXTENSION_TAG_DPVL:
+		return "Digital packet video link extension"
+	case EXTENSION_TAG_DISPLAYID:
+		return "DisplayID extension"
+	case EXTENSION_TAG_BLOCK_MAP:
+		return "Block map"
+	case EXTENSION_TAG_MANUFACTURER:
+		return "Manufacturer specific extension"
+	default:
+		return "Unknown extension"
+	}
+}
+
+func printExtension(ext Extension) {
+	fmt.Printf("Extension block %d: %s (tag 0x%02x)\n", ext.Index, extensionTagName(ext.Tag), ext.Tag)
+	if ext.CTA != nil {
+		printCTAExtension(ext.CTA)
+	}
+	if ext.DisplayID != nil {
+		printDisplayIDExtension(ext.DisplayID)
+	}
+	fmt.Printf("Extension checksum: 0x%02x is valid: %t\n", ext.Checksum, ext.ChecksumValid)
+}
+
+// Print writes the decoded EDID to stdout in a human readable form.
+func (decoded DecodedEDID) Print() {
+	fmt.Println("Manufacturer ID: ", decoded.ManufacturerID)
+	fmt.Printf("Product Code: %d\n", decoded.ProductCode)
+	fmt.Printf("Serial Number: %d\n", decoded.SerialNumber)
+	fmt.Printf("Week of Manufacture: %d\n", decoded.WeekOfManufacture)
+	fmt.Printf("Year of Manufacture: %d\n", decoded.YearOfManufacture)
+	fmt.Printf("EDID Version: %d.%d\n", decoded.Version, decoded.Revision)
+
+	fmt.Printf("Basic Display Parameters:\n")
+	printBDP(decoded.BasicDisplayParameters)
+
+	fmt.Printf("Chromaticity Coordinates:\n")
+	printChromaticityCoordinates(decoded.ChromaticityCoordinates)
+
+	fmt.Printf("Established Timings:\n")
+	printEstablishedTimings(decoded.EstablishedTimings, "\t")
+
+	fmt.Printf("Standard Timings:\n")
+	printStandardTimings(decoded.StandardTimings, "\t")
+
+	fmt.Printf("Display Timing Descriptor:\n")
+	printDisplayDescriptor(decoded.DisplayDescriptors)
+
+	fmt.Printf("Extension Flag: 0x%02X\n", decoded.ExtensionFlag)
+	fmt.Printf("Checksum: 0x%02X\n", decoded.Checksum)
+	fmt.Printf("Checksum Valid: %t\n", decoded.ChecksumValid)
+
+	for _, ext := range decoded.Extensions {
+		printExtension(ext)
+	}
+
+	fmt.Println("Modes:")
+	printModes(decoded.Modes)
+}
@@ -49,10 +49,15 @@ type VTBDescriptor struct {
 	vSyncWidthMSB   byte // 1 byte vertical sync pulse MSB
 }
 
+type ExtensionBlock struct {
+	tag  byte                 // 1 byte extension tag
+	data [EXTENSION_SIZE]byte // 128 bytes extension block
+}
+
 type EDID struct {
 	edidData                [EDID_SIZE]byte                                         // 128 bytes edid
-	ctaData                 [CTA_SIZE]byte                                          // 1 byte CTA extension tag
-	rawData                 [EXTENDED_EDID_SIZE]byte                                // 256 bytes edid
+	extensions              []ExtensionBlock                                        // 128 bytes per extension block
+	rawData                 []byte                                                  // 128 bytes edid + 128 bytes per extension block
 	fixedHeader             [FIXED_HEADER_SIZE]byte                                 // 8 bytes fixed edid header
 	manufacturerId          [MANUFACTURER_ID_SIZE]byte                              // 2 bytes manufacturer id
 	productCode             [PRODUCT_CODE_SIZE]byte                                 // 2 bytes product code
@@ -69,3 +74,601 @@ type EDID struct {
 	extensionFlag           byte                                                    // 1 byte extension flag
 	checksum                byte                                                    // 1 byte checksum
 }
+
+type DisplayIDStructure struct {
+	sections [][]byte // raw sections, header through checksum
+}
+
+// Timing is a fully resolved video timing, shared by every descriptor type
+// that describes one.
+type Timing struct {
+	PixelClock             float64 // pixel clock in MHz
+	HorizontalActive       int     // horizontal addressable pixels
+	HorizontalBlanking     int     // horizontal blanking pixels
+	HorizontalFrontPorch   int     // horizontal front porch pixels
+	HorizontalSyncWidth    int     // horizontal sync pulse width pixels
+	HorizontalSyncPositive bool    // horizontal sync polarity is positive
+	VerticalActive         int     // vertical addressable lines
+	VerticalBlanking       int     // vertical blanking lines
+	VerticalFrontPorch     int     // vertical front porch lines
+	VerticalSyncWidth      int     // vertical sync pulse width lines
+	VerticalSyncPositive   bool    // vertical sync polarity is positive
+	Interlaced             bool    // interlaced timing
+	RefreshRate            float64 // refresh rate in Hz
+}
+
+type DetailedTimingFeatures struct {
+	Stereo       string // stereo viewing support
+	DigitalSync  bool   // digital sync, analog sync otherwise
+	SeparateSync bool   // digital separate sync, composite sync otherwise
+	Serrated     bool   // serrated vsync
+	BipolarSync  bool   // analog bipolar composite sync
+	SyncOnAllRGB bool   // analog sync on all RGB signals, sync on green otherwise
+}
+
+type DetailedTimingDescriptor struct {
+	Timing
+	ImageWidth       int                    // horizontal image size in mm
+	ImageHeight      int                    // vertical image size in mm
+	HorizontalBorder int                    // horizontal border pixels
+	VerticalBorder   int                    // vertical border lines
+	Features         DetailedTimingFeatures // signal features
+}
+
+type SecondaryGTF struct {
+	StartFrequency int     // start break frequency in kHz
+	C              float64 // C parameter
+	M              int     // M parameter
+	K              int     // K parameter
+	J              float64 // J parameter
+}
+
+type CVTSupport struct {
+	Version              string   // CVT version
+	MaxPixelClock        float64  // maximum pixel clock in MHz with the additional precision
+	MaxActivePixels      int      // maximum active pixels per line, 0 when not limited
+	AspectRatios         []string // supported aspect ratios
+	PreferredAspectRatio string   // preferred aspect ratio
+	ReducedBlanking      bool     // CVT reduced blanking supported
+	StandardBlanking     bool     // CVT standard blanking supported
+	Scaling              []string // supported display scaling
+	PreferredRefreshRate int      // preferred vertical refresh rate in Hz
+}
+
+type RangeLimits struct {
+	VerticalRateMin       int           // minimum vertical field rate in Hz
+	VerticalRateMax       int           // maximum vertical field rate in Hz
+	HorizontalRateMin     int           // minimum horizontal line rate in kHz
+	HorizontalRateMax     int           // maximum horizontal line rate in kHz
+	MaxPixelClock         int           // maximum pixel clock in MHz
+	ExtendedTimingType    byte          // extended timing information type
+	VideoTimingParameters [7]byte       // video timing parameters
+	SecondaryGTF          *SecondaryGTF // secondary GTF parameters
+	CVT                   *CVTSupport   // CVT parameters
+}
+
+type CVTCodeTiming struct {
+	Timing
+	NominalRefreshRate int  // nominal vertical refresh rate in Hz
+	ReducedBlanking    bool // CVT reduced blanking timing
+	Preferred          bool // preferred vertical refresh rate
+}
+
+type CVTCode struct {
+	HorizontalActive     int             // horizontal addressable pixels derived from the aspect ratio
+	VerticalActive       int             // vertical addressable lines
+	AspectRatio          string          // aspect ratio
+	PreferredRefreshRate int             // preferred vertical refresh rate in Hz
+	Timings              []CVTCodeTiming // supported refresh rates expanded with the CVT formula
+}
+
+type WhitePoint struct {
+	Index int     // white point index, 1 is the base block white point
+	X     float64 // white point x
+	Y     float64 // white point y
+	Gamma float64 // gamma, 0 when defined in an extension block
+}
+
+type ColorManagement struct {
+	Version int     // color management data version
+	RedA3   float64 // red third order coefficient
+	RedA2   float64 // red second order coefficient
+	GreenA3 float64 // green third order coefficient
+	GreenA2 float64 // green second order coefficient
+	BlueA3  float64 // blue third order coefficient
+	BlueA2  float64 // blue second order coefficient
+}
+
+type DisplayDescriptor struct {
+	Index              int                       // descriptor slot in the base block
+	Type               byte                      // display descriptor tag, unused for detailed timings
+	DetailedTiming     *DetailedTimingDescriptor // detailed timing descriptor
+	Text               string                    // name, serial number or manufacturer string
+	RangeLimits        *RangeLimits              // display range limits
+	EstablishedTimings []EstablishedTiming       // established timings III
+	StandardTimings    []StandardTiming          // standard timing identification
+	CVTCodes           []CVTCode                 // CVT 3-byte codes
+	WhitePoints        []WhitePoint              // additional white points
+	ColorManagement    *ColorManagement          // display color management (DCM) data
+}
+
+type BasicDisplayParameters struct {
+	Digital            bool    // digital video input
+	BitDepth           int     // bits per color, 0 when undefined
+	VideoInterface     string  // digital video interface
+	WhiteAndSyncLevels string  // analog video white and sync levels
+	BlankToBlackSetup  bool    // analog blank to black setup (pedestal) expected
+	SeparateSync       bool    // analog separate sync supported
+	CompositeSync      bool    // analog composite sync supported
+	SyncOnGreen        bool    // analog sync on green supported
+	VSyncSerrated      bool    // analog vsync serrated
+	MaxHorizontalSize  int     // maximum horizontal image size in cm
+	MaxVerticalSize    int     // maximum vertical image size in cm
+	Gamma              float64 // display gamma
+	DPMSStandby        bool    // DPMS standby supported
+	DPMSSuspend        bool    // DPMS suspend supported
+	DPMSActiveOff      bool    // DPMS active-off supported
+	DisplayType        string  // display color type or supported color encodings
+}
+
+type ChromaticityCoordinates struct {
+	RedX   float64 // red x
+	RedY   float64 // red y
+	GreenX float64 // green x
+	GreenY float64 // green y
+	BlueX  float64 // blue x
+	BlueY  float64 // blue y
+	WhiteX float64 // white point x
+	WhiteY float64 // white point y
+}
+
+type EstablishedTiming struct {
+	HorizontalActive int  // horizontal addressable pixels
+	VerticalActive   int  // vertical addressable lines
+	RefreshRate      int  // refresh rate in Hz
+	ReducedBlanking  bool // CVT reduced blanking timing
+	DMTID            int  // DMT ID, 0 when the timing is not looked up in the DMT table
+}
+
+type StandardTiming struct {
+	Unused           bool   // slot is unused (0x01 0x01)
+	HorizontalActive int    // horizontal addressable pixels
+	VerticalActive   int    // vertical addressable lines
+	RefreshRate      int    // refresh rate in Hz
+	AspectRatio      string // image aspect ratio
+}
+
+type TiledDisplayTopology struct {
+	Revision           byte    // block revision
+	OneTileBehavior    byte    // behavior when only one tile is driven
+	NTileBehavior      byte    // behavior when more than one tile is driven
+	BezelInformation   bool    // bezel information is present
+	SingleEnclosure    bool    // all tiles are in a single physical enclosure
+	HorizontalTiles    int     // number of horizontal tiles
+	VerticalTiles      int     // number of vertical tiles
+	HorizontalLocation int     // horizontal location of this tile
+	VerticalLocation   int     // vertical location of this tile
+	TileWidth          int     // tile width in pixels
+	TileHeight         int     // tile height in lines
+	PixelMultiplier    int     // pixel multiplier of the bezel sizes
+	TopBezel           float64 // top bezel size in pixels
+	BottomBezel        float64 // bottom bezel size in pixels
+	RightBezel         float64 // right bezel size in pixels
+	LeftBezel          float64 // left bezel size in pixels
+	VendorID           string  // topology vendor PNP ID (1.3) or IEEE OUI (2.x)
+	ProductCode        uint16  // topology product code
+	SerialNumber       uint32  // topology serial number
+}
+
+type DisplayIDTiming struct {
+	Timing
+	Options     byte     // timing options
+	Preferred   bool     // preferred timing
+	Stereo      string   // 3D stereo support
+	AspectRatio string   // picture aspect ratio
+	Code        string   // timing code of enumerated timings, e.g. "DMT 0x52"
+	Formula     string   // timing formula of formula-based timings
+	Flags       []string // formula specific flags
+}
+
+type DisplayIDProductIdentification struct {
+	VendorID          string // PNP ID (1.3) or IEEE OUI (2.x)
+	ProductCode       uint16 // product code
+	SerialNumber      uint32 // serial number
+	WeekOfManufacture int    // week of manufacture, 0 when not specified
+	ModelYear         bool   // year is a model year instead of a year of manufacture
+	YearOfManufacture int    // year of manufacture or model year
+	ProductName       string // product name
+}
+
+type DisplayIDDisplayParameters struct {
+	ImageWidth           float64                 // horizontal image size in mm
+	ImageHeight          float64                 // vertical image size in mm
+	HorizontalPixels     int                     // native horizontal pixel count, 0 when not specified
+	VerticalPixels       int                     // native vertical pixel count, 0 when not specified
+	Features             []string                // supported features (1.3)
+	Gamma                float64                 // transfer characteristic gamma, 0 when not specified
+	AspectRatio          float64                 // aspect ratio (1.3), 0 when not specified
+	NativeBitDepth       int                     // native bits per color (1.3)
+	OverallBitDepth      int                     // overall bits per color (1.3)
+	ScanOrientation      string                  // scan orientation (2.x)
+	LuminanceInformation string                  // meaning of the luminance values (2.x)
+	AudioIntegrated      bool                    // audio speakers are integrated (2.x)
+	ColorSpace           string                  // CIE color space of the primaries (2.x)
+	Primaries            ChromaticityCoordinates // color primaries and white point (2.x)
+	MaxLuminanceFull     float64                 // max luminance at full coverage in cd/m² (2.x)
+	MaxLuminance10       float64                 // max luminance at 10% coverage in cd/m² (2.x)
+	MinLuminance         float64                 // min luminance in cd/m² (2.x)
+	BitDepth             int                     // color bit depth per primary, 0 when not defined (2.x)
+	Technology           string                  // display device technology (2.x)
+}
+
+type DisplayIDInterfaceFeatures struct {
+	RGBDepths        []int    // supported RGB bits per component
+	YCbCr444Depths   []int    // supported YCbCr 4:4:4 bits per component
+	YCbCr422Depths   []int    // supported YCbCr 4:2:2 bits per component
+	YCbCr420Depths   []int    // supported YCbCr 4:2:0 bits per component
+	MinPixelRate420  float64  // minimum pixel rate in MHz for YCbCr 4:2:0, 0 when all rates are supported
+	AudioSampleRates []string // supported audio sample rates
+	ColorSpaces      []string // supported color space and EOTF combinations
+}
+
+type DisplayIDStereoInterface struct {
+	TimingSupport string            // which timings support stereo
+	MethodCode    byte              // stereo interface method code
+	Method        string            // stereo interface method
+	Details       []string          // method specific parameters
+	Timings       []DisplayIDTiming // timings listed as stereo capable
+}
+
+type DisplayIDAdaptiveSync struct {
+	NativePanelRange    bool    // range is the native panel range
+	VTotal              string  // fixed average and adaptive V-Total support
+	SeamlessTransition  bool    // seamless transition between refresh rates
+	MaxIncreaseNoJitter bool    // max duration increase has no jitter impact
+	MaxDecreaseNoJitter bool    // max duration decrease has no jitter impact
+	MaxDurationIncrease float64 // max single frame duration increase in ms
+	MinRefreshRate      int     // minimum refresh rate in Hz
+	MaxRefreshRate      int     // maximum refresh rate in Hz
+	MaxDurationDecrease float64 // max single frame duration decrease in ms
+}
+
+type DisplayIDColorCharacteristics struct {
+	Temporal    bool         // temporal instead of spatial color
+	CIE1976     bool         // coordinates are CIE 1976 instead of CIE 1931
+	TransferID  int          // associated transfer characteristics identifier, 0 when none
+	ColorSpace  string       // color space when no primaries are listed
+	Primaries   []ColorPoint // color primaries
+	WhitePoints []ColorPoint // white points
+}
+
+type ColorPoint struct {
+	X float64 // x coordinate
+	Y float64 // y coordinate
+}
+
+type DisplayIDRangeLimits struct {
+	MinPixelClock     float64 // minimum pixel clock in MHz
+	MaxPixelClock     float64 // maximum pixel clock in MHz
+	MinHorizontalRate int     // minimum horizontal frequency in kHz
+	MaxHorizontalRate int     // maximum horizontal frequency in kHz
+	MinHBlank         int     // minimum horizontal blanking in pixels
+	MinVerticalRate   int     // minimum vertical refresh rate in Hz
+	MaxVerticalRate   int     // maximum vertical refresh rate in Hz
+	MinVBlank         int     // minimum vertical blanking in lines
+	Interlaced        bool    // interlaced timings supported
+	CVT               bool    // CVT standard blanking supported
+	CVTReduced        bool    // CVT reduced blanking supported
+	DiscreteFrequency bool    // discrete frequency display device
+}
+
+type DisplayIDPowerSequencing struct {
+	T1Min float64 // T1 minimum in ms
+	T1Max float64 // T1 maximum in ms
+	T2Max float64 // T2 maximum in ms, the minimum is 0
+	T3Max float64 // T3 maximum in ms, the minimum is 0
+	T4Min float64 // T4 minimum in ms
+	T5Min float64 // T5 minimum in ms
+	T6Min float64 // T6 minimum in ms
+}
+
+type DisplayIDTransferCurve struct {
+	Name       string    // white curve or response curve number
+	Samples    []float64 // accumulated samples in percent of full scale
+	Parameters []int     // A0 to A3 of a parameterised curve
+	Gamma      float64   // gamma of a parameterised curve
+}
+
+type DisplayIDTransferCharacteristics struct {
+	ID               int                      // transfer characteristics identifier, 0 when none
+	FirstIsWhite     bool                     // first curve is the white curve
+	IndividualCurves bool                     // individual response curve per primary
+	Curves           []DisplayIDTransferCurve // response curves
+}
+
+type DisplayIDLegacyInterface struct {
+	Type                     string  // interface type
+	Channels                 int     // number of channels or links, 0 for analog interfaces
+	Version                  string  // interface standard version
+	RGBDepths                []int   // supported RGB bits per component
+	YCbCr444Depths           []int   // supported YCbCr 4:4:4 bits per component
+	YCbCr422Depths           []int   // supported YCbCr 4:2:2 bits per component
+	ContentProtection        string  // content protection
+	ContentProtectionVersion string  // content protection version
+	SpreadSpectrum           string  // spread spectrum type
+	SpreadSpectrumPercent    float64 // spread spectrum amount in percent
+}
+
+type DisplayIDBlock struct {
+	Tag                  byte                              // data block tag
+	Revision             byte                              // data block revision
+	Length               int                               // number of payload bytes
+	ProductID            *DisplayIDProductIdentification   // product identification
+	Parameters           *DisplayIDDisplayParameters       // display parameters
+	Interface            *DisplayIDInterfaceFeatures       // display interface features
+	Stereo               *DisplayIDStereoInterface         // stereo display interface
+	ContainerID          string                            // ContainerID UUID
+	AdaptiveSync         []DisplayIDAdaptiveSync           // adaptive sync descriptors
+	OUI                  uint32                            // IEEE OUI of vendor-specific blocks
+	VendorData           []byte                            // vendor-specific payload after the OUI
+	ColorCharacteristics *DisplayIDColorCharacteristics    // color characteristics
+	RangeLimits          *DisplayIDRangeLimits             // video timing range limits
+	String               string                            // serial number or ASCII string
+	PowerSequencing      *DisplayIDPowerSequencing         // power sequencing
+	Transfer             *DisplayIDTransferCharacteristics // transfer characteristics
+	LegacyInterface      *DisplayIDLegacyInterface         // display interface (1.3)
+	TiledTopology        *TiledDisplayTopology             // tiled display topology
+	Timings              []DisplayIDTiming                 // video timings
+}
+
+type DisplayIDSection struct {
+	Revision        byte             // DisplayID version and revision
+	Length          int              // number of bytes in section
+	ProductType     byte             // display product type (1.3) or primary use case (2.x)
+	ProductTypeName string           // name of the display product type or primary use case
+	ExtensionCount  int              // number of extension sections
+	Blocks          []DisplayIDBlock // data blocks
+	Checksum        byte             // section checksum
+	ChecksumValid   bool             // section checksum is valid
+}
+
+type DisplayID struct {
+	Sections []DisplayIDSection // DisplayID sections
+}
+
+// DecodedDisplayID is the fully decoded content of a standalone DisplayID structure.
+type DecodedDisplayID struct {
+	Sections []DisplayIDSection // decoded sections
+	Warnings []string           // decoding warnings
+}
+
+type ShortVideoDescriptor struct {
+	VIC         int     // video identification code
+	Native      bool    // native video format
+	AspectRatio string  // picture aspect ratio
+	Timing      *Timing // timing from the CTA-861 VIC table, nil for unknown VICs
+	YCbCr420    bool    // also supports YCbCr 4:2:0 according to the 4:2:0 capability map
+}
+
+type ShortAudioDescriptor struct {
+	Format        int      // audio format code
+	ExtensionType int      // audio format extension type code, only valid when Format is 15
+	FormatName    string   // audio format name
+	MaxChannels   int      // maximum number of channels, 0 when not specified
+	SampleRates   []int    // supported sample rates in Hz
+	BitDepths     []int    // supported L-PCM bit depths
+	MaxBitrate    int      // maximum bitrate in kbit/s
+	FormatValue   byte     // raw format dependent value (byte 3)
+	Flags         []string // format specific capabilities
+}
+
+type RoomConfiguration struct {
+	SpeakerCount            int      // number of speakers, 0 when not valid
+	SpeakerLocationsPresent bool     // speaker location descriptors are present
+	Speakers                []string // speaker allocation
+	DisplayPresent          bool     // display location is present
+	MaxX                    int      // room X dimension in dm, 0 when not present
+	MaxY                    int      // room Y dimension in dm, 0 when not present
+	MaxZ                    int      // room Z dimension in dm, 0 when not present
+	DisplayX                float64  // display X location as a fraction of MaxX
+	DisplayY                float64  // display Y location as a fraction of MaxY
+	DisplayZ                float64  // display Z location as a fraction of MaxZ
+}
+
+type SpeakerLocation struct {
+	ChannelIndex       int     // channel index
+	Active             bool    // speaker is active
+	SpeakerID          int     // CTA-861 speaker ID
+	Speaker            string  // speaker name
+	CoordinatesPresent bool    // X/Y/Z coordinates are present
+	X                  float64 // X location as a fraction of the room MaxX
+	Y                  float64 // Y location as a fraction of the room MaxY
+	Z                  float64 // Z location as a fraction of the room MaxZ
+}
+
+type HDMIVideoFormat struct {
+	HDMIVIC int     // HDMI VIC
+	VIC     int     // equivalent CTA-861 VIC
+	Timing  *Timing // timing of the equivalent CTA-861 VIC
+}
+
+type HDMI3DStructure struct {
+	SVDIndex  int    // index of the SVD in the video data block
+	Structure int    // 3D structure code
+	Name      string // 3D structure name
+	Detail    int    // 3D detail, only valid for side-by-side (half)
+}
+
+type HDMIVSDB struct {
+	PhysicalAddress        string            // CEC physical address a.b.c.d
+	SupportsAI             bool              // supports ACP, ISRC1 or ISRC2 packets
+	DeepColor48            bool              // 16 bits per component deep color
+	DeepColor36            bool              // 12 bits per component deep color
+	DeepColor30            bool              // 10 bits per component deep color
+	DeepColorY444          bool              // deep color in YCbCr 4:4:4
+	DVIDual                bool              // DVI dual-link operation
+	MaxTMDSClock           int               // maximum TMDS clock in MHz, 0 when not indicated
+	ContentTypes           []string          // supported content types (CNC)
+	LatencyPresent         bool              // progressive latency fields present
+	VideoLatency           int               // progressive video latency in ms, 0 when unknown, -1 when not supported
+	AudioLatency           int               // progressive audio latency in ms, 0 when unknown, -1 when not supported
+	InterlacedLatency      bool              // interlaced latency fields present
+	InterlacedVideoLatency int               // interlaced video latency in ms, 0 when unknown, -1 when not supported
+	InterlacedAudioLatency int               // interlaced audio latency in ms, 0 when unknown, -1 when not supported
+	HDMIVideoPresent       bool              // HDMI video fields present
+	Present3D              bool              // mandatory 3D formats supported
+	Multi3D                int               // 3D multi present
+	ImageSize              int               // image size information
+	HDMIVICs               []HDMIVideoFormat // HDMI VICs
+	Structures3D           []string          // 3D structures supported by all or masked VICs
+	Mask3D                 uint16            // SVDs to which Structures3D apply, only valid when Multi3D is 2
+	Entries3D              []HDMI3DStructure // individual 3D structures per SVD
+}
+
+type HDMIForumSCDS struct {
+	Version              int  // SCDS version
+	MaxTMDSCharacterRate int  // maximum TMDS character rate in Mcsc, 0 when not above 340 Mcsc
+	SCDCPresent          bool // SCDC present
+	RRCapable            bool // SCDC read request capable
+	CableStatus          bool // cable status reporting
+	CCBPCI               bool // color content bits per component indication
+	LTE340McscScramble   bool // scrambling below 340 Mcsc
+	IndependentView3D    bool // 3D independent view signaling
+	DualView3D           bool // 3D dual view signaling
+	OSDDisparity3D       bool // 3D OSD disparity indication
+	MaxFRLRate           int  // maximum FRL rate code
+	FRLLanes             int  // number of FRL lanes at the maximum FRL rate
+	FRLRate              int  // FRL rate per lane in Gbit/s at the maximum FRL rate
+	UHDVIC               bool // UHD VICs supported
+	DeepColor48420       bool // 16 bits per component deep color in YCbCr 4:2:0
+	DeepColor36420       bool // 12 bits per component deep color in YCbCr 4:2:0
+	DeepColor30420       bool // 10 bits per component deep color in YCbCr 4:2:0
+	FAPAStartLocation    bool // fast audio packet adjust start location
+	ALLM                 bool // auto low latency mode
+	FVA                  bool // fast vactive
+	CNMVRR               bool // negative M_VRR values supported
+	CinemaVRR            bool // cinema VRR
+	MDelta               bool // M_CONST timing change support
+	QMS                  bool // quick media switching
+	FAPAEndExtended      bool // fast audio packet adjust end extended
+	VRRMin               int  // minimum VRR refresh rate in Hz, 0 when not indicated
+	VRRMax               int  // maximum VRR refresh rate in Hz, 0 when not indicated
+	DSC12                bool // VESA DSC 1.2a supported
+	DSCNative420         bool // DSC compressed native YCbCr 4:2:0
+	QMSTFRMax            bool // QMS TFRmax supported
+	QMSTFRMin            bool // QMS TFRmin supported
+	DSCAllBPP            bool // DSC all bits per pixel supported
+	DSC16BPC             bool // DSC 16 bits per component
+	DSC12BPC             bool // DSC 12 bits per component
+	DSC10BPC             bool // DSC 10 bits per component
+	DSCMaxFRLRate        int  // DSC maximum FRL rate code
+	DSCFRLLanes          int  // number of FRL lanes at the DSC maximum FRL rate
+	DSCFRLRate           int  // FRL rate per lane in Gbit/s at the DSC maximum FRL rate
+	DSCMaxSlices         int  // DSC maximum number of slices
+	DSCMaxPixelClock     int  // DSC maximum pixel clock per slice in MHz
+	DSCTotalChunkBytes   int  // DSC maximum number of bytes in a line of chunks
+}
+
+type Colorimetry struct {
+	Colorimetries    []string // supported colorimetry standards
+	MetadataProfiles []int    // supported gamut metadata profiles
+}
+
+type VideoCapability struct {
+	QuantizationYCC bool   // YCC quantization range selectable
+	QuantizationRGB bool   // RGB quantization range selectable
+	PreferredScan   string // preferred video timing scan behavior
+	ITScan          string // IT video format scan behavior
+	CEScan          string // CE video format scan behavior
+}
+
+type HDRStaticMetadata struct {
+	EOTFs                    []string // supported electro-optical transfer functions
+	MetadataTypes            []int    // supported static metadata descriptor types
+	MaxLuminance             float64  // desired content max luminance in cd/m², 0 when not present
+	MaxFrameAverageLuminance float64  // desired content max frame-average luminance in cd/m², 0 when not present
+	MinLuminance             float64  // desired content min luminance in cd/m²
+	MinLuminancePresent      bool     // desired content min luminance is present
+}
+
+type HDRDynamicMetadata struct {
+	Type    int      // HDR dynamic metadata type
+	Name    string   // name of the metadata type
+	Version int      // supported version of the metadata type, -1 when not present
+	Flags   []string // type-specific support flags
+}
+
+type CTADataBlock struct {
+	Tag              byte                   // data block tag code
+	ExtendedTag      byte                   // extended tag code, only valid when Tag is CTA_EXT_TAG_USE_EXTENDED_TAG
+	Length           int                    // number of payload bytes
+	Payload          []byte                 // payload bytes following the data block header
+	VideoDescriptors []ShortVideoDescriptor // short video descriptors
+	AudioDescriptors []ShortAudioDescriptor // short audio descriptors
+	Speakers         []string               // speaker allocation data block
+	RoomConfig       *RoomConfiguration     // room configuration data block
+	SpeakerLocations []SpeakerLocation      // speaker location data block
+	OUI              uint32                 // IEEE OUI of vendor-specific data blocks
+	HDMI             *HDMIVSDB              // HDMI 1.4 vendor-specific data block
+	HDMIForum        *HDMIForumSCDS         // HDMI Forum HF-VSDB or HF-SCDB sink capability data structure
+	Colorimetry      *Colorimetry           // colorimetry data block
+	VideoCapability  *VideoCapability       // video capability data block
+	YCbCr420Map      []int                  // SVD indices flagged by the YCbCr 4:2:0 capability map
+	YCbCr420MapAll   bool                   // YCbCr 4:2:0 capability map without bitmap, applies to all SVDs
+	HDRStatic        *HDRStaticMetadata     // HDR static metadata data block
+	HDRDynamic       []HDRDynamicMetadata   // HDR dynamic metadata data block
+}
+
+type CTAExtension struct {
+	Revision        int                        // CTA-861 extension revision
+	DTDOffset       int                        // offset of the first detailed timing descriptor
+	Underscan       bool                       // sink underscans IT video formats by default
+	BasicAudio      bool                       // sink supports basic audio
+	YCbCr444        bool                       // sink supports YCbCr 4:4:4
+	YCbCr422        bool                       // sink supports YCbCr 4:2:2
+	NativeDTDs      int                        // number of native detailed timing descriptors
+	DataBlocks      []CTADataBlock             // data block collection
+	DetailedTimings []DetailedTimingDescriptor // detailed timing descriptors
+}
+
+type Extension struct {
+	Index         int           // extension block number, starting at 1
+	Tag           byte          // extension block tag
+	CTA           *CTAExtension // CTA-861 extension
+	DisplayID     *DisplayID    // DisplayID section
+	Checksum      byte          // extension block checksum
+	ChecksumValid bool          // extension block checksum is valid
+}
+
+type Mode struct {
+	HorizontalActive int     // horizontal addressable pixels
+	VerticalActive   int     // vertical addressable lines per frame
+	RefreshRate      float64 // vertical refresh rate in Hz
+	Interlaced       bool    // interlaced mode
+	Native           bool    // native mode
+	Preferred        bool    // preferred timing mode
+	Source           string  // structure the mode was found in
+	Timing           *Timing // full timing, nil when only the resolution and refresh rate are known
+}
+
+// DecodedEDID is the fully decoded content of an EDID and its extensions.
+type DecodedEDID struct {
+	ManufacturerID          string                  // three letter PNP ID
+	ProductCode             uint16                  // manufacturer product code
+	SerialNumber            uint32                  // serial number
+	WeekOfManufacture       int                     // week of manufacture
+	YearOfManufacture       int                     // year of manufacture
+	Version                 int                     // EDID version
+	Revision                int                     // EDID revision
+	BasicDisplayParameters  BasicDisplayParameters  // basic display parameters
+	ChromaticityCoordinates ChromaticityCoordinates // chromaticity coordinates
+	EstablishedTimings      []EstablishedTiming     // established timings
+	StandardTimings         []StandardTiming        // standard timings
+	DisplayDescriptors      []DisplayDescriptor     // display descriptors
+	ExtensionFlag           int                     // number of extension blocks
+	Checksum                byte                    // base block checksum
+	ChecksumValid           bool                    // base block checksum is valid
+	Extensions              []Extension             // decoded extension blocks
+	Modes                   []Mode                  // all modes from the base block and the extensions
+	Warnings                []string                // decoding warnings
+}